@@ -0,0 +1,40 @@
+package slice
+
+// Builder accumulates elements with amortized growth, zero value is ready to use
+type Builder[T any] struct {
+	elems Slice[T]
+}
+
+func (b *Builder[T]) Append(elems ...T) {
+	b.elems = Append(b.elems, elems...)
+}
+
+// Grow reserves room for n more elements, so the next n appended ones don't reallocate
+func (b *Builder[T]) Grow(n int) {
+	if n < 0 {
+		panic("slice.Builder.Grow: negative n")
+	}
+	if b.elems.Cap()-b.elems.Len() >= n {
+		return
+	}
+
+	grown := Make[T](b.elems.Len(), b.elems.Len()+n)
+	Copy(grown, b.elems)
+	b.elems = grown
+}
+
+func (b *Builder[T]) Len() int {
+	return b.elems.Len()
+}
+
+// Build returns the accumulated slice clipped to its length and resets the builder
+func (b *Builder[T]) Build() Slice[T] {
+	if b.elems.IsNil() {
+		return Make[T](0)
+	}
+
+	res := b.elems.Sliced(0, b.elems.Len(), b.elems.Len())
+	b.elems = Slice[T]{}
+
+	return res
+}
@@ -0,0 +1,80 @@
+package slice
+
+import "testing"
+
+func TestBuilder(t *testing.T) {
+	var b Builder[int]
+	for i := 0; i < 10000; i++ {
+		b.Append(i)
+	}
+
+	if b.Len() != 10000 {
+		t.Fatalf("Len() = %d, want 10000", b.Len())
+	}
+
+	res := b.Build()
+	assertElems(t, res, toBuiltin(Iota(10000))...)
+	if res.Cap() != res.Len() {
+		t.Fatalf("built slice is not clipped: Cap() = %d", res.Cap())
+	}
+
+	// Build resets the builder
+	if b.Len() != 0 {
+		t.Fatalf("Len() after Build = %d, want 0", b.Len())
+	}
+	b.Append(1, 2)
+	assertElems(t, b.Build(), 1, 2)
+	assertElems(t, res.Sliced(0, 2), 0, 1)
+
+	var empty Builder[int]
+	if res := empty.Build(); res.IsNil() || res.Len() != 0 {
+		t.Fatalf("Build of empty builder = %v", res)
+	}
+}
+
+func TestBuilderGrow(t *testing.T) {
+	var b Builder[int]
+	b.Append(1, 2)
+	b.Grow(100)
+
+	if b.elems.Cap() != 102 {
+		t.Fatalf("Cap() after Grow = %d, want 102", b.elems.Cap())
+	}
+	for i := 0; i < 100; i++ {
+		b.Append(i)
+	}
+	if b.elems.Cap() != 102 {
+		t.Fatalf("appending within the grown room reallocated, Cap() = %d", b.elems.Cap())
+	}
+
+	res := b.Build()
+	assertElems(t, res.Sliced(0, 3), 1, 2, 0)
+	if res.Len() != 102 {
+		t.Fatalf("Len() = %d, want 102", res.Len())
+	}
+
+	b.Grow(0)
+	assertElems(t, b.Build())
+	assertPanics(t, func() { b.Grow(-1) })
+}
+
+// Grow presizes once, repeated Append regrows about log(n) times
+func BenchmarkBuilderGrow(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		var bld Builder[int]
+		bld.Grow(10000)
+		for j := 0; j < 10000; j++ {
+			bld.Append(j)
+		}
+		_ = bld.Build()
+	}
+}
+
+func BenchmarkRepeatedAppend(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		var s Slice[int]
+		for j := 0; j < 10000; j++ {
+			s = Append(s, j)
+		}
+	}
+}
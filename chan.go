@@ -0,0 +1,47 @@
+package slice
+
+import "context"
+
+const toChanBuffer = 64
+
+// ToChan spawns a goroutine sending elements in order to the returned buffered channel.
+// The channel is closed when all elements are sent or ctx is done, the goroutine exits then as well.
+// A caller that stops receiving early must cancel ctx, otherwise the goroutine blocks on a send forever.
+// Values already buffered may still be received after cancellation
+func (s Slice[T]) ToChan(ctx context.Context) <-chan T {
+	bufSize := toChanBuffer
+	if s.Len() < bufSize {
+		bufSize = s.Len()
+	}
+
+	ch := make(chan T, bufSize)
+	go func() {
+		defer close(ch)
+
+		for i := 0; i < s.Len(); i++ {
+			select {
+			case ch <- s.Get(i):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// FromChan collects values from ch until it is closed or ctx is done, returns what was gathered
+func FromChan[T any](ctx context.Context, ch <-chan T) Slice[T] {
+	res := Make[T](0)
+	for {
+		select {
+		case v, ok := <-ch:
+			if !ok {
+				return res
+			}
+			res = Append(res, v)
+		case <-ctx.Done():
+			return res
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package slice
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestToChan(t *testing.T) {
+	var got []int
+	for v := range Iota(200).ToChan(context.Background()) {
+		got = append(got, v)
+	}
+	assertElems(t, New(got...), toBuiltin(Iota(200))...)
+
+	for range New[int]().ToChan(context.Background()) {
+		t.Fatal("empty slice sent a value")
+	}
+}
+
+// waitGoroutines waits until at most n goroutines are running
+func waitGoroutines(t *testing.T, n int) {
+	t.Helper()
+
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > n {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d goroutines running, want at most %d", runtime.NumGoroutine(), n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestToChanCancel(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := Iota(1000).ToChan(ctx)
+	<-ch
+	cancel()
+
+	// The producer stops and closes the channel instead of sending all elements
+	n := 0
+	for range ch {
+		n++
+	}
+	if n >= 999 {
+		t.Fatalf("received %d more elements after cancel", n)
+	}
+
+	waitGoroutines(t, before)
+}
+
+func TestFromChan(t *testing.T) {
+	ch := make(chan int)
+	go func() {
+		defer close(ch)
+		for i := 0; i < 5; i++ {
+			ch <- i
+		}
+	}()
+	assertElems(t, FromChan(context.Background(), ch), 0, 1, 2, 3, 4)
+}
+
+func TestFromChanCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	ch := make(chan int)
+	go func() {
+		ch <- 1
+		ch <- 2
+		cancel()
+	}()
+
+	// ch is never closed, only the cancellation stops collecting
+	assertElems(t, FromChan(ctx, ch), 1, 2)
+}
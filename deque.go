@@ -0,0 +1,84 @@
+package slice
+
+// Deque double-ended queue over a ring buffer, zero value is ready to use.
+// Pushes are amortized O(1): a full buffer is reallocated with Append growth rules.
+// Storage order is not element order, use ForEach to iterate front to back
+type Deque[T any] struct {
+	buf  Slice[T] // Len() == Cap()
+	head int
+	size int
+}
+
+func (d *Deque[T]) PushFront(val T) {
+	d.grow()
+
+	d.head = (d.head - 1 + d.buf.Len()) % d.buf.Len()
+	d.buf.Set(d.head, val)
+	d.size++
+}
+
+func (d *Deque[T]) PushBack(val T) {
+	d.grow()
+
+	d.buf.Set(d.index(d.size), val)
+	d.size++
+}
+
+func (d *Deque[T]) PopFront() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+
+	val := d.buf.Get(d.head)
+	d.buf.Set(d.head, zero) // Release reference
+	d.head = d.index(1)
+	d.size--
+
+	return val, true
+}
+
+func (d *Deque[T]) PopBack() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+
+	last := d.index(d.size - 1)
+	val := d.buf.Get(last)
+	d.buf.Set(last, zero)
+	d.size--
+
+	return val, true
+}
+
+func (d *Deque[T]) Len() int {
+	return d.size
+}
+
+// ForEach calls f for each element from front to back
+func (d *Deque[T]) ForEach(f func(T)) {
+	for i := 0; i < d.size; i++ {
+		f(d.buf.Get(d.index(i)))
+	}
+}
+
+// index in buf of the i-th element from front
+func (d *Deque[T]) index(i int) int {
+	return (d.head + i) % d.buf.Len()
+}
+
+func (d *Deque[T]) grow() {
+	if d.size < d.buf.Len() {
+		return
+	}
+
+	newCap := nextSliceCapacity(d.size+1, d.buf.Cap())
+	newBuf := Make[T](newCap)
+	for i := 0; i < d.size; i++ {
+		newBuf.Set(i, d.buf.Get(d.index(i)))
+	}
+
+	d.buf = newBuf
+	d.head = 0
+}
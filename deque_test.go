@@ -0,0 +1,76 @@
+package slice
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestDeque(t *testing.T) {
+	var d Deque[int]
+	if _, ok := d.PopFront(); ok {
+		t.Fatal("PopFront() on empty deque succeeded")
+	}
+	if _, ok := d.PopBack(); ok {
+		t.Fatal("PopBack() on empty deque succeeded")
+	}
+
+	d.PushBack(2)
+	d.PushFront(1)
+	d.PushBack(3)
+	d.PushFront(0)
+
+	var got []int
+	d.ForEach(func(v int) { got = append(got, v) })
+	assertElems(t, New(got...), 0, 1, 2, 3)
+
+	if v, _ := d.PopFront(); v != 0 {
+		t.Fatalf("PopFront() = %d, want 0", v)
+	}
+	if v, _ := d.PopBack(); v != 3 {
+		t.Fatalf("PopBack() = %d, want 3", v)
+	}
+	if d.Len() != 2 {
+		t.Fatalf("Len() = %d, want 2", d.Len())
+	}
+}
+
+func TestDequeStress(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	var d Deque[int]
+	var ref []int
+	for i := 0; i < 200000; i++ {
+		switch r.Intn(4) {
+		case 0:
+			d.PushFront(i)
+			ref = append([]int{i}, ref...)
+		case 1:
+			d.PushBack(i)
+			ref = append(ref, i)
+		case 2:
+			v, ok := d.PopFront()
+			if ok != (len(ref) > 0) || ok && v != ref[0] {
+				t.Fatalf("PopFront() = %v, %v at step %d", v, ok, i)
+			}
+			if ok {
+				ref = ref[1:]
+			}
+		case 3:
+			v, ok := d.PopBack()
+			if ok != (len(ref) > 0) || ok && v != ref[len(ref)-1] {
+				t.Fatalf("PopBack() = %v, %v at step %d", v, ok, i)
+			}
+			if ok {
+				ref = ref[:len(ref)-1]
+			}
+		}
+
+		if d.Len() != len(ref) {
+			t.Fatalf("Len() = %d, want %d at step %d", d.Len(), len(ref), i)
+		}
+	}
+
+	var got []int
+	d.ForEach(func(v int) { got = append(got, v) })
+	assertElems(t, New(got...), ref...)
+}
@@ -0,0 +1,63 @@
+package slice
+
+// MapError applies f to each element, stops at the first error and returns it with a nil slice
+func MapError[T, U any](s Slice[T], f func(T) (U, error)) (Slice[U], error) {
+	res := Make[U](s.Len())
+	for i := 0; i < s.Len(); i++ {
+		v, err := f(s.Get(i))
+		if err != nil {
+			return Slice[U]{}, err
+		}
+		res.Set(i, v)
+	}
+
+	return res, nil
+}
+
+// FilterError keeps elements satisfying pred, on the first error returns the elements kept so far and it
+func FilterError[T any](s Slice[T], pred func(T) (bool, error)) (Slice[T], error) {
+	res := Make[T](0)
+	for i := 0; i < s.Len(); i++ {
+		v := s.Get(i)
+		ok, err := pred(v)
+		if err != nil {
+			return res, err
+		}
+
+		if ok {
+			res = Append(res, v)
+		}
+	}
+
+	return res, nil
+}
+
+// ReduceError folds s with f, on the first error returns the last good accumulator and it
+func ReduceError[T, A any](s Slice[T], init A, f func(acc A, v T) (A, error)) (A, error) {
+	acc := init
+	for i := 0; i < s.Len(); i++ {
+		next, err := f(acc, s.Get(i))
+		if err != nil {
+			return acc, err
+		}
+		acc = next
+	}
+
+	return acc, nil
+}
+
+// Batch calls f on consecutive chunks of size (the last may be shorter) and stops at the first error.
+// Chunks are views of s, f must not retain them
+func (s Slice[T]) Batch(size int, f func(Slice[T]) error) error {
+	if size <= 0 {
+		panic("slice.Batch: non-positive size")
+	}
+
+	for chunk := range s.Chunks(size) {
+		if err := f(chunk); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
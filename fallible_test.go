@@ -0,0 +1,102 @@
+package slice
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestMapError(t *testing.T) {
+	res, err := MapError(New("1", "2", "3"), strconv.Atoi)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertElems(t, res, 1, 2, 3)
+
+	calls := 0
+	res, err = MapError(New("1", "x", "3"), func(s string) (int, error) {
+		calls++
+		return strconv.Atoi(s)
+	})
+	if err == nil || !res.IsNil() {
+		t.Fatalf("MapError = %v, %v, want nil slice and an error", res, err)
+	}
+	if calls != 2 {
+		t.Fatalf("f called %d times, want 2", calls)
+	}
+}
+
+var errTest = errors.New("test error")
+
+func TestFilterError(t *testing.T) {
+	even := func(v int) (bool, error) { return v%2 == 0, nil }
+
+	res, err := FilterError(New(1, 2, 3, 4), even)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertElems(t, res, 2, 4)
+
+	res, err = FilterError(New(2, 4, -1, 6), func(v int) (bool, error) {
+		if v < 0 {
+			return false, errTest
+		}
+		return even(v)
+	})
+	if !errors.Is(err, errTest) {
+		t.Fatalf("err = %v, want %v", err, errTest)
+	}
+	assertElems(t, res, 2, 4)
+}
+
+func TestReduceError(t *testing.T) {
+	sum := func(acc int, s string) (int, error) {
+		v, err := strconv.Atoi(s)
+		return acc + v, err
+	}
+
+	got, err := ReduceError(New("1", "2", "3"), 0, sum)
+	if err != nil || got != 6 {
+		t.Fatalf("ReduceError = %d, %v, want 6, nil", got, err)
+	}
+
+	got, err = ReduceError(New("1", "2", "x", "4"), 0, sum)
+	if err == nil || got != 3 {
+		t.Fatalf("ReduceError = %d, %v, want the last good accumulator 3 and an error", got, err)
+	}
+
+	got, err = ReduceError(New[string](), 7, sum)
+	if err != nil || got != 7 {
+		t.Fatalf("ReduceError of empty = %d, %v, want 7, nil", got, err)
+	}
+}
+
+func TestBatch(t *testing.T) {
+	var batches [][]int
+	err := Iota(7).Batch(3, func(b Slice[int]) error {
+		batches = append(batches, toBuiltin(b))
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][]int{{0, 1, 2}, {3, 4, 5}, {6}}; !reflect.DeepEqual(batches, want) {
+		t.Fatalf("batches = %v, want %v", batches, want)
+	}
+
+	calls := 0
+	err = Iota(7).Batch(3, func(b Slice[int]) error {
+		calls++
+		if calls == 2 {
+			return errTest
+		}
+		return nil
+	})
+	if !errors.Is(err, errTest) || calls != 2 {
+		t.Fatalf("Batch = %v after %d calls, want errTest after 2", err, calls)
+	}
+
+	noop := func(Slice[int]) error { return nil }
+	assertPanicsWith(t, "slice.Batch: non-positive size", func() { Iota(7).Batch(0, noop) })
+}
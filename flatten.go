@@ -0,0 +1,57 @@
+package slice
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// anySlice lets a Slice of unknown element type be walked at runtime
+type anySlice interface {
+	Len() int
+	getAny(idx int) any
+}
+
+func (s Slice[T]) getAny(idx int) any {
+	return s.Get(idx)
+}
+
+// FlattenDepth flattens nested Slices: depth 0 expects Slice[T], depth 1 Slice[Slice[T]] and so on.
+// Levels are walked through interfaces with every element boxed, so it is much slower than typed loops
+func FlattenDepth[T any](nested any, depth int) (Slice[T], error) {
+	if depth < 0 {
+		return Slice[T]{}, errors.New("slice.FlattenDepth: negative depth")
+	}
+
+	res := Make[T](0)
+	if err := flattenInto(&res, nested, depth); err != nil {
+		return Slice[T]{}, err
+	}
+
+	return res, nil
+}
+
+func flattenInto[T any](res *Slice[T], nested any, depth int) error {
+	s, ok := nested.(anySlice)
+	if !ok {
+		return fmt.Errorf("slice.FlattenDepth: %T is not a Slice", nested)
+	}
+
+	for i := 0; i < s.Len(); i++ {
+		elem := s.getAny(i)
+		if depth > 0 {
+			if err := flattenInto(res, elem, depth-1); err != nil {
+				return err
+			}
+			continue
+		}
+
+		leaf, ok := elem.(T)
+		if !ok {
+			return fmt.Errorf("slice.FlattenDepth: leaf %T is not %v", elem, reflect.TypeFor[T]())
+		}
+		*res = Append(*res, leaf)
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package slice
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFlattenDepth(t *testing.T) {
+	twoLevels := New(New(1, 2), New(3))
+	res, err := FlattenDepth[int](twoLevels, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertElems(t, res, 1, 2, 3)
+
+	threeLevels := New(New(New(1, 2), New(3)), New(New(4)))
+	res, err = FlattenDepth[int](threeLevels, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertElems(t, res, 1, 2, 3, 4)
+
+	res, err = FlattenDepth[int](New(1, 2), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertElems(t, res, 1, 2)
+}
+
+func TestFlattenDepthMismatch(t *testing.T) {
+	threeLevels := New(New(New(1, 2)))
+
+	if _, err := FlattenDepth[int](threeLevels, 1); err == nil {
+		t.Fatal("leaf of a wrong type accepted")
+	}
+	if _, err := FlattenDepth[string](threeLevels, 2); err == nil {
+		t.Fatal("leaf of a wrong type accepted")
+	}
+	if _, err := FlattenDepth[int](New(1), 1); err == nil {
+		t.Fatal("non-Slice level accepted")
+	}
+	if _, err := FlattenDepth[int](New(1), -1); err == nil {
+		t.Fatal("negative depth accepted")
+	}
+
+	_, err := FlattenDepth[fmt.Stringer](New(New(1)), 1)
+	if err == nil || err.Error() != "slice.FlattenDepth: leaf int is not fmt.Stringer" {
+		t.Fatalf("interface leaf error = %v", err)
+	}
+}
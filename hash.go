@@ -0,0 +1,68 @@
+package slice
+
+import "hash/maphash"
+
+var hashSeed = maphash.MakeSeed()
+
+// Hash is order-sensitive: equal slices hash equally, unequal ones may collide.
+// Nil and empty slices hash the same. A NaN element (NaN != NaN) hashes randomly on every call,
+// so slices containing NaN have no stable hash. The seed is per process, so do not persist hashes
+func Hash[T comparable](s Slice[T]) uint64 {
+	var h maphash.Hash
+	h.SetSeed(hashSeed)
+
+	for i := 0; i < s.Len(); i++ {
+		maphash.WriteComparable(&h, s.Get(i))
+	}
+
+	return h.Sum64()
+}
+
+// IndexOfSubsliceFast is IndexOfSubslice via Rabin–Karp rolling hash, average O(n+m).
+// Hash matches are verified element-wise
+func IndexOfSubsliceFast[T comparable](s, sub Slice[T]) int {
+	n, m := s.Len(), sub.Len()
+	if m == 0 {
+		return 0
+	}
+	if m > n {
+		return -1
+	}
+
+	const base = 1099511628211 // FNV-64 prime, wraps mod 2^64
+
+	elemHash := func(v T) uint64 {
+		return maphash.Comparable(hashSeed, v)
+	}
+
+	// base^(m-1) to remove the leading element
+	var lead uint64 = 1
+	for i := 1; i < m; i++ {
+		lead *= base
+	}
+
+	// Each element of s is hashed exactly once and its hash kept in a ring of the last m,
+	// since NaN hashes differently on every call and must be subtracted as it was added
+	window := make([]uint64, m)
+
+	var subHash, winHash uint64
+	for i := 0; i < m; i++ {
+		subHash = subHash*base + elemHash(sub.Get(i))
+		window[i] = elemHash(s.Get(i))
+		winHash = winHash*base + window[i]
+	}
+
+	for i := 0; ; i++ {
+		if winHash == subHash && StartsWith(s.Sliced(i, n), sub) {
+			return i
+		}
+
+		if i+m == n {
+			return -1
+		}
+
+		next := elemHash(s.Get(i + m))
+		winHash = (winHash-window[i%m]*lead)*base + next
+		window[i%m] = next
+	}
+}
@@ -0,0 +1,117 @@
+package slice
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestHashEqualInputs(t *testing.T) {
+	if Hash(New(1, 2, 3)) != Hash(New(1, 2, 3)) {
+		t.Fatal("equal slices hash differently")
+	}
+
+	// Backing array or capacity must not matter
+	if Hash(New(0, 1, 2, 3).Sliced(1, 4)) != Hash(New(1, 2, 3)) {
+		t.Fatal("equal subslice hashes differently")
+	}
+
+	var nilS Slice[string]
+	if Hash(nilS) != Hash(New[string]()) {
+		t.Fatal("nil and empty slices hash differently")
+	}
+}
+
+func TestHashSmoke(t *testing.T) {
+	if Hash(New(1, 2, 3)) == Hash(New(3, 2, 1)) {
+		t.Fatal("hash is not order-sensitive")
+	}
+	if Hash(New("ab")) == Hash(New("a", "b")) {
+		t.Fatal("element boundaries do not affect the hash")
+	}
+
+	seen := make(map[uint64]Slice[int])
+	for i := 0; i < 1000; i++ {
+		for j := 0; j < 10; j++ {
+			s := New(i, j)
+			h := Hash(s)
+			if prev, ok := seen[h]; ok {
+				t.Fatalf("collision between %v and %v", prev, s)
+			}
+			seen[h] = s
+		}
+	}
+}
+
+func TestIndexOfSubsliceFast(t *testing.T) {
+	for _, tc := range subsliceCases {
+		if got := IndexOfSubsliceFast(tc.s, tc.sub); got != tc.idx {
+			t.Fatalf("IndexOfSubsliceFast(%v, %v) = %d, want %d", tc.s, tc.sub, got, tc.idx)
+		}
+	}
+}
+
+func TestIndexOfSubsliceFastRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	gen := func(n int) Slice[int] {
+		return Generate(n, func(int) int { return r.Intn(3) })
+	}
+
+	for iter := 0; iter < 20000; iter++ {
+		s, sub := gen(r.Intn(30)), gen(r.Intn(5))
+		if got, want := IndexOfSubsliceFast(s, sub), IndexOfSubslice(s, sub); got != want {
+			t.Fatalf("IndexOfSubsliceFast(%v, %v) = %d, want %d", s, sub, got, want)
+		}
+	}
+
+	// NaN hashes randomly on every call, it must not corrupt the windows after it
+	values := []float64{math.NaN(), 1, 2, 3}
+	genFloat := func(n int) Slice[float64] {
+		return Generate(n, func(int) float64 { return values[r.Intn(len(values))] })
+	}
+
+	for iter := 0; iter < 20000; iter++ {
+		s, sub := genFloat(r.Intn(30)), genFloat(r.Intn(5))
+		if got, want := IndexOfSubsliceFast(s, sub), IndexOfSubslice(s, sub); got != want {
+			t.Fatalf("IndexOfSubsliceFast(%v, %v) = %d, want %d", s, sub, got, want)
+		}
+	}
+}
+
+func TestIndexOfSubsliceFastNaN(t *testing.T) {
+	if got := IndexOfSubsliceFast(New(math.NaN(), 1.0, 2.0, 3.0), New(2.0, 3.0)); got != 2 {
+		t.Fatalf("got %d, want 2", got)
+	}
+
+	type point struct{ x, y float64 }
+	s := New(point{math.NaN(), 0}, point{1, 1}, point{2, 2})
+	if got := IndexOfSubsliceFast(s, New(point{1, 1}, point{2, 2})); got != 1 {
+		t.Fatalf("struct with NaN field: got %d, want 1", got)
+	}
+}
+
+// Near-miss prefix everywhere, the only match is at the end
+func subsliceBenchInput() (s, sub Slice[int]) {
+	const n, m = 1 << 16, 64
+
+	s = Make[int](n)
+	sub = Make[int](m)
+	sub.Set(m-1, 1)
+	s.Set(n-1, 1)
+
+	return s, sub
+}
+
+func BenchmarkIndexOfSubsliceFast(b *testing.B) {
+	s, sub := subsliceBenchInput()
+	for i := 0; i < b.N; i++ {
+		IndexOfSubsliceFast(s, sub)
+	}
+}
+
+func BenchmarkIndexOfSubslice(b *testing.B) {
+	s, sub := subsliceBenchInput()
+	for i := 0; i < b.N; i++ {
+		IndexOfSubslice(s, sub)
+	}
+}
@@ -0,0 +1,172 @@
+package slice
+
+import "iter"
+
+// Permutations yields every ordering of s (Heap's algorithm), each as an independent copy
+func Permutations[T any](s Slice[T]) iter.Seq[Slice[T]] {
+	return func(yield func(Slice[T]) bool) {
+		work := Make[T](s.Len())
+		Copy(work, s)
+
+		emit := func() bool {
+			p := Make[T](work.Len())
+			Copy(p, work)
+			return yield(p)
+		}
+
+		if !emit() {
+			return
+		}
+
+		counters := Make[int](work.Len())
+		for i := 1; i < work.Len(); {
+			if counters.Get(i) >= i {
+				counters.Set(i, 0)
+				i++
+				continue
+			}
+
+			if i%2 == 0 {
+				work.swap(0, i)
+			} else {
+				work.swap(counters.Get(i), i)
+			}
+
+			if !emit() {
+				return
+			}
+
+			counters.Set(i, counters.Get(i)+1)
+			i = 1
+		}
+	}
+}
+
+// Combinations yields every k-element combination in source order, each as an independent copy.
+// k == 0 yields one empty combination, k < 0 or k > Len() yields nothing
+func Combinations[T any](s Slice[T], k int) iter.Seq[Slice[T]] {
+	return func(yield func(Slice[T]) bool) {
+		n := s.Len()
+		if k < 0 || k > n {
+			return
+		}
+
+		idx := Iota(k)
+		for {
+			c := Make[T](k)
+			for i := 0; i < k; i++ {
+				c.Set(i, s.Get(idx.Get(i)))
+			}
+			if !yield(c) {
+				return
+			}
+
+			// Rightmost index that can still move
+			i := k - 1
+			for i >= 0 && idx.Get(i) == n-k+i {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+
+			idx.Set(i, idx.Get(i)+1)
+			for j := i + 1; j < k; j++ {
+				idx.Set(j, idx.Get(j-1)+1)
+			}
+		}
+	}
+}
+
+// CartesianProduct yields every (x, y) pair with x from a and y from b, a-major order
+func CartesianProduct[A, B any](a Slice[A], b Slice[B]) iter.Seq[Pair[A, B]] {
+	return func(yield func(Pair[A, B]) bool) {
+		for i := 0; i < a.Len(); i++ {
+			for j := 0; j < b.Len(); j++ {
+				if !yield(Pair[A, B]{a.Get(i), b.Get(j)}) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// ReverseValues yields elements from last to first without copying
+func (s Slice[T]) ReverseValues() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := s.Len() - 1; i >= 0; i-- {
+			if !yield(s.Get(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Chunks yields consecutive chunks of size (the last may be shorter).
+// Chunks are views of s (capacity limited to chunk length), do not retain them across mutations of s
+func (s Slice[T]) Chunks(size int) iter.Seq[Slice[T]] {
+	if size <= 0 {
+		panic("slice.Chunks: non-positive size")
+	}
+
+	return func(yield func(Slice[T]) bool) {
+		for low := 0; low < s.Len(); low += size {
+			high := low + size
+			if high > s.Len() {
+				high = s.Len()
+			}
+
+			if !yield(s.Sliced(low, high, high)) {
+				return
+			}
+		}
+	}
+}
+
+// Windows yields sliding windows of size, nothing if size > Len().
+// Windows are views of s (capacity limited to size), do not retain them across mutations of s
+func (s Slice[T]) Windows(size int) iter.Seq[Slice[T]] {
+	if size <= 0 {
+		panic("slice.Windows: non-positive size")
+	}
+
+	return func(yield func(Slice[T]) bool) {
+		for i := 0; i+size <= s.Len(); i++ {
+			if !yield(s.Sliced(i, i+size, i+size)) {
+				return
+			}
+		}
+	}
+}
+
+// SlidingReduce folds each sliding window of size starting from init, one result per window
+func SlidingReduce[T, A any](s Slice[T], size int, init A, f func(acc A, v T) A) Slice[A] {
+	if size <= 0 {
+		panic("slice.SlidingReduce: non-positive size")
+	}
+
+	res := Make[A](0)
+	for w := range s.Windows(size) {
+		acc := init
+		for i := 0; i < w.Len(); i++ {
+			acc = f(acc, w.Get(i))
+		}
+		res = Append(res, acc)
+	}
+
+	return res
+}
+
+// SlidingWindowFunc collects f over each sliding window of size, windows are views f must not retain
+func SlidingWindowFunc[T, R any](s Slice[T], size int, f func(window Slice[T]) R) Slice[R] {
+	if size <= 0 {
+		panic("slice.SlidingWindowFunc: non-positive size")
+	}
+
+	res := Make[R](0)
+	for w := range s.Windows(size) {
+		res = Append(res, f(w))
+	}
+
+	return res
+}
@@ -0,0 +1,202 @@
+package slice
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestPermutations(t *testing.T) {
+	seen := make(map[string]bool)
+	var perms []Slice[int]
+	for p := range Permutations(New(1, 2, 3)) {
+		perms = append(perms, p)
+		seen[p.String()] = true
+	}
+
+	if len(perms) != 6 || len(seen) != 6 {
+		t.Fatalf("got %d permutations (%d distinct), want 6", len(perms), len(seen))
+	}
+
+	// Yielded slices are independent copies
+	perms[0].Set(0, 100)
+	if perms[1].Get(0) == 100 {
+		t.Fatal("yielded permutations share memory")
+	}
+}
+
+func TestPermutationsEarlyBreak(t *testing.T) {
+	n := 0
+	for range Permutations(New(1, 2, 3, 4)) {
+		n++
+		if n == 2 {
+			break
+		}
+	}
+
+	if n != 2 {
+		t.Fatalf("got %d iterations, want 2", n)
+	}
+}
+
+func TestCombinations(t *testing.T) {
+	var combs []string
+	for c := range Combinations(New(1, 2, 3, 4), 2) {
+		combs = append(combs, c.String())
+	}
+	want := []string{"[1 2]", "[1 3]", "[1 4]", "[2 3]", "[2 4]", "[3 4]"}
+	if !reflect.DeepEqual(combs, want) {
+		t.Fatalf("got %v, want %v", combs, want)
+	}
+
+	for _, tc := range []struct{ n, k, count int }{
+		{5, 0, 1},
+		{5, 1, 5},
+		{5, 3, 10},
+		{5, 5, 1},
+		{5, 6, 0},
+		{5, -1, 0},
+		{6, 3, 20},
+		{0, 0, 1},
+	} {
+		count := 0
+		for range Combinations(Iota(tc.n), tc.k) {
+			count++
+		}
+
+		if count != tc.count {
+			t.Fatalf("C(%d, %d): got %d combinations, want %d", tc.n, tc.k, count, tc.count)
+		}
+	}
+}
+
+func TestCombinationsEarlyBreak(t *testing.T) {
+	n := 0
+	for range Combinations(Iota(6), 3) {
+		n++
+		if n == 3 {
+			break
+		}
+	}
+
+	if n != 3 {
+		t.Fatalf("got %d iterations, want 3", n)
+	}
+}
+
+func TestCartesianProduct(t *testing.T) {
+	var pairs []Pair[int, string]
+	for p := range CartesianProduct(New(1, 2), New("a", "b", "c")) {
+		pairs = append(pairs, p)
+	}
+
+	want := []Pair[int, string]{{1, "a"}, {1, "b"}, {1, "c"}, {2, "a"}, {2, "b"}, {2, "c"}}
+	if !reflect.DeepEqual(pairs, want) {
+		t.Fatalf("got %v, want %v", pairs, want)
+	}
+
+	for range CartesianProduct(New(1, 2), New[string]()) {
+		t.Fatal("product with an empty slice must be empty")
+	}
+}
+
+func TestReverseValues(t *testing.T) {
+	var got []int
+	for v := range New(1, 2, 3).ReverseValues() {
+		got = append(got, v)
+	}
+	if want := []int{3, 2, 1}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	got = got[:0]
+	for v := range New(1, 2, 3).ReverseValues() {
+		got = append(got, v)
+		break
+	}
+	if want := []int{3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("early break: got %v, want %v", got, want)
+	}
+
+	var nilS Slice[int]
+	for range nilS.ReverseValues() {
+		t.Fatal("nil slice yielded a value")
+	}
+}
+
+func TestChunks(t *testing.T) {
+	var chunks [][]int
+	for c := range Iota(5).Chunks(2) {
+		chunks = append(chunks, toBuiltin(c))
+	}
+	if want := [][]int{{0, 1}, {2, 3}, {4}}; !reflect.DeepEqual(chunks, want) {
+		t.Fatalf("got %v, want %v", chunks, want)
+	}
+
+	n := 0
+	for range Iota(10).Chunks(3) {
+		n++
+		if n == 2 {
+			break
+		}
+	}
+	if n != 2 {
+		t.Fatalf("early break: got %d chunks, want 2", n)
+	}
+
+	assertPanics(t, func() { Iota(3).Chunks(0) })
+}
+
+func TestWindows(t *testing.T) {
+	s := New(1, 3, 2, 5, 4)
+
+	var maxes []int
+	for w := range s.Windows(3) {
+		m := w.Get(0)
+		for i := 1; i < w.Len(); i++ {
+			if w.Get(i) > m {
+				m = w.Get(i)
+			}
+		}
+		maxes = append(maxes, m)
+	}
+	if want := []int{3, 5, 5}; !reflect.DeepEqual(maxes, want) {
+		t.Fatalf("moving max = %v, want %v", maxes, want)
+	}
+
+	for range s.Windows(6) {
+		t.Fatal("window larger than the slice")
+	}
+
+	assertPanics(t, func() { s.Windows(0) })
+}
+
+func TestSlidingReduce(t *testing.T) {
+	s := New(1, 2, 3, 4, 5)
+	add := func(acc, v int) int { return acc + v }
+
+	res := SlidingReduce(s, 2, 0, add)
+	want := make([]int, 0)
+	for i := 0; i+2 <= s.Len(); i++ {
+		want = append(want, s.Get(i)+s.Get(i+1))
+	}
+	assertElems(t, res, want...)
+
+	assertElems(t, SlidingReduce(s, 6, 0, add))
+	assertPanicsWith(t, "slice.SlidingReduce: non-positive size", func() { SlidingReduce(s, 0, 0, add) })
+}
+
+func TestSlidingWindowFunc(t *testing.T) {
+	median := func(w Slice[int]) int {
+		elems := toBuiltin(w)
+		sort.Ints(elems)
+		return elems[len(elems)/2]
+	}
+
+	s := New(5, 1, 4, 2, 8, 3)
+	assertElems(t, SlidingWindowFunc(s, 3, median), 4, 2, 4, 3)
+	assertElems(t, s, 5, 1, 4, 2, 8, 3)
+
+	assertElems(t, SlidingWindowFunc(s, 7, median))
+	assertPanicsWith(t, "slice.SlidingWindowFunc: non-positive size", func() { SlidingWindowFunc(s, 0, median) })
+}
@@ -0,0 +1,91 @@
+package slice
+
+type Numeric interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// CumSum returns running totals: res[i] = s[0] + ... + s[i]
+func CumSum[T Numeric](s Slice[T]) Slice[T] {
+	res := Make[T](s.Len())
+
+	var sum T
+	for i := 0; i < s.Len(); i++ {
+		sum += s.Get(i)
+		res.Set(i, sum)
+	}
+
+	return res
+}
+
+// Range from start (inclusive) to stop (exclusive) by step, negative step counts down.
+// Element i is start + i*step, so float steps do not accumulate rounding drift
+func Range[T Numeric](start, stop, step T) Slice[T] {
+	var zero T
+	if step == zero {
+		panic("slice.Range: zero step")
+	}
+
+	res := Make[T](0)
+	for i, v := 1, start; (step > zero && v < stop) || (step < zero && v > stop); i++ {
+		res = Append(res, v)
+
+		// Overflow or a step lost to float precision
+		next := start + T(i)*step
+		if (step > zero && next <= v) || (step < zero && next >= v) {
+			break
+		}
+		v = next
+	}
+
+	return res
+}
+
+// Iota returns [0, 1, ..., n-1]
+func Iota(n int) Slice[int] {
+	if n < 0 {
+		panic("slice.Iota: negative n")
+	}
+
+	return Range(0, n, 1)
+}
+
+// EqualApprox compares elements within absolute tolerance epsilon, NaN never equals anything (NaN too)
+func EqualApprox[T ~float32 | ~float64](a, b Slice[T], epsilon T) bool {
+	if a.Len() != b.Len() {
+		return false
+	}
+
+	for i := 0; i < a.Len(); i++ {
+		x, y := a.Get(i), b.Get(i)
+		if x != x || y != y {
+			return false
+		}
+
+		// Covers equal infinities
+		if x == y {
+			continue
+		}
+
+		diff := x - y
+		if diff < 0 {
+			diff = -diff
+		}
+		if diff > epsilon {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SumBy sums sel(v) over s
+func SumBy[T any, N Numeric](s Slice[T], sel func(T) N) N {
+	var sum N
+	for i := 0; i < s.Len(); i++ {
+		sum += sel(s.Get(i))
+	}
+
+	return sum
+}
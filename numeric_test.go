@@ -0,0 +1,113 @@
+package slice
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCumSum(t *testing.T) {
+	s := New(3, -1, 4, 1, 5)
+	res := CumSum(s)
+
+	sum := 0
+	for i := 0; i < s.Len(); i++ {
+		sum += s.Get(i)
+		if res.Get(i) != sum {
+			t.Fatalf("CumSum[%d] = %d, want %d", i, res.Get(i), sum)
+		}
+	}
+
+	if res.Len() != s.Len() || res.Get(res.Len()-1) != sum {
+		t.Fatalf("CumSum = %v, last must be the total %d", res, sum)
+	}
+
+	assertElems(t, CumSum(New[float64]()))
+}
+
+func TestRange(t *testing.T) {
+	assertElems(t, Range(0, 10, 3), 0, 3, 6, 9)
+	assertElems(t, Range(10, 0, -3), 10, 7, 4, 1)
+	assertElems(t, Range(5, 5, 1))
+	assertElems(t, Range(5, 0, 1))
+	assertElems(t, Range(0.0, 1.0, 0.25), 0, 0.25, 0.5, 0.75)
+	assertElems(t, Range[uint8](250, 255, 2), 250, 252, 254)
+	assertElems(t, Iota(4), 0, 1, 2, 3)
+	assertElems(t, Iota(0))
+
+	assertPanics(t, func() { Range(0, 10, 0) })
+}
+
+func TestRangeStuckStep(t *testing.T) {
+	// 16777216 + 1 == 16777216 in float32
+	assertElems(t, Range[float32](16777216, 16777220, 1), 16777216)
+	assertElems(t, Range[float32](-16777216, -16777220, -1), -16777216)
+}
+
+func TestRangeFloatDrift(t *testing.T) {
+	r := Range(0.0, 1.0, 0.1)
+	if r.Len() != 10 {
+		t.Fatalf("Range(0, 1, 0.1) has %d elements, want 10", r.Len())
+	}
+	for i := 0; i < r.Len(); i++ {
+		if want := float64(i) * 0.1; r.Get(i) != want {
+			t.Fatalf("element %d = %v, want %v", i, r.Get(i), want)
+		}
+	}
+
+	if down := Range(1.0, 0.0, -0.1); down.Len() != 10 {
+		t.Fatalf("Range(1, 0, -0.1) has %d elements, want 10", down.Len())
+	}
+}
+
+func TestRangeOverflow(t *testing.T) {
+	assertElems(t, Range[int8](120, 127, 5), 120, 125)
+	assertElems(t, Range[int8](-120, -128, -5), -120, -125)
+}
+
+func TestEqualApprox(t *testing.T) {
+	a := New(0.1+0.2, 1.0, 2.0)
+	b := New(0.3, 1.0+1e-10, 2.0)
+
+	if !EqualApprox(a, b, 1e-9) {
+		t.Fatal("slightly perturbed slices are not approximately equal")
+	}
+	if EqualApprox(a, New(0.3, 1.1, 2.0), 1e-9) {
+		t.Fatal("difference above epsilon is equal")
+	}
+	if EqualApprox(a, New(0.3, 1.0), 1e-9) {
+		t.Fatal("length mismatch is equal")
+	}
+
+	nan := math.NaN()
+	if EqualApprox(New(nan), New(nan), 1) {
+		t.Fatal("NaN equals NaN")
+	}
+
+	inf := math.Inf(1)
+	if !EqualApprox(New(inf), New(inf), 0) || EqualApprox(New(inf), New(-inf), 1) {
+		t.Fatal("infinities compared wrong")
+	}
+}
+
+func TestSumBy(t *testing.T) {
+	type order struct {
+		id    int
+		price float64
+	}
+	orders := New(order{1, 9.5}, order{2, 0.5}, order{3, 10})
+	price := func(o order) float64 { return o.price }
+
+	if got := SumBy(orders, price); got != 20 {
+		t.Fatalf("SumBy = %v, want 20", got)
+	}
+
+	// Same as Map + Sum
+	prices := MapIndex(orders, func(_ int, o order) float64 { return o.price })
+	if got, want := SumBy(orders, price), CumSum(prices).Get(prices.Len()-1); got != want {
+		t.Fatalf("SumBy = %v, want %v", got, want)
+	}
+
+	if got := SumBy(New[order](), price); got != 0 {
+		t.Fatalf("SumBy of empty = %v, want 0", got)
+	}
+}
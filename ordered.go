@@ -0,0 +1,189 @@
+package slice
+
+import (
+	"cmp"
+	"math/rand"
+	"sort"
+)
+
+// MinBy returns the element with the smallest key, the first one on ties
+func MinBy[T any, K cmp.Ordered](s Slice[T], key func(T) K) (T, bool) {
+	return extremeBy(s, key, func(a, b K) bool { return a < b })
+}
+
+// MaxBy returns the element with the largest key, the first one on ties
+func MaxBy[T any, K cmp.Ordered](s Slice[T], key func(T) K) (T, bool) {
+	return extremeBy(s, key, func(a, b K) bool { return a > b })
+}
+
+func extremeBy[T any, K cmp.Ordered](s Slice[T], key func(T) K, better func(a, b K) bool) (res T, ok bool) {
+	if s.Len() == 0 {
+		return
+	}
+
+	res = s.Get(0)
+	resKey := key(res)
+	for i := 1; i < s.Len(); i++ {
+		v := s.Get(i)
+		if k := key(v); better(k, resKey) {
+			res, resKey = v, k
+		}
+	}
+
+	return res, true
+}
+
+// Clamp returns a new slice with each element clamped into [lo, hi]
+func Clamp[T cmp.Ordered](s Slice[T], lo, hi T) Slice[T] {
+	if lo > hi {
+		panic("slice.Clamp: lo greater than hi")
+	}
+
+	res := Make[T](s.Len())
+	for i := 0; i < s.Len(); i++ {
+		v := s.Get(i)
+		if v < lo {
+			v = lo
+		} else if v > hi {
+			v = hi
+		}
+		res.Set(i, v)
+	}
+
+	return res
+}
+
+// IndexOfMax returns index of the largest element (the first one on ties) or -1 for empty s
+func IndexOfMax[T cmp.Ordered](s Slice[T]) int {
+	return extremeIndex(s, func(a, b T) bool { return a > b })
+}
+
+// IndexOfMin returns index of the smallest element (the first one on ties) or -1 for empty s
+func IndexOfMin[T cmp.Ordered](s Slice[T]) int {
+	return extremeIndex(s, func(a, b T) bool { return a < b })
+}
+
+func extremeIndex[T cmp.Ordered](s Slice[T], better func(a, b T) bool) int {
+	if s.Len() == 0 {
+		return -1
+	}
+
+	res := 0
+	for i := 1; i < s.Len(); i++ {
+		if better(s.Get(i), s.Get(res)) {
+			res = i
+		}
+	}
+
+	return res
+}
+
+// SortBy stably sorts s in place by key, computing key once per element
+func SortBy[T any, K cmp.Ordered](s Slice[T], key func(T) K) {
+	keys := Make[K](s.Len())
+	for i := 0; i < s.Len(); i++ {
+		keys.Set(i, key(s.Get(i)))
+	}
+
+	sort.Stable(keySorter[T, K]{s, keys})
+}
+
+// keySorter sorts elems by precomputed keys, keeping both aligned
+type keySorter[T any, K cmp.Ordered] struct {
+	elems Slice[T]
+	keys  Slice[K]
+}
+
+func (ks keySorter[T, K]) Len() int {
+	return ks.elems.Len()
+}
+
+func (ks keySorter[T, K]) Less(i, j int) bool {
+	return cmp.Less(ks.keys.Get(i), ks.keys.Get(j))
+}
+
+func (ks keySorter[T, K]) Swap(i, j int) {
+	ks.elems.swap(i, j)
+	ks.keys.swap(i, j)
+}
+
+// TopK returns the k largest elements in descending order, k is clamped into [0, Len()]
+func TopK[T cmp.Ordered](s Slice[T], k int) Slice[T] {
+	return boundedTop(s, k, cmp.Less[T])
+}
+
+// BottomK returns the k smallest elements in ascending order, k is clamped into [0, Len()]
+func BottomK[T cmp.Ordered](s Slice[T], k int) Slice[T] {
+	return boundedTop(s, k, func(a, b T) bool { return cmp.Less(b, a) })
+}
+
+// boundedTop keeps the k "greatest" per less in a heap whose root is the least of them, O(n log k)
+func boundedTop[T any](s Slice[T], k int, less func(a, b T) bool) Slice[T] {
+	k = clampCount(k, s.Len())
+
+	pq := NewPriorityQueue(less)
+	for i := 0; i < s.Len() && k > 0; i++ {
+		v := s.Get(i)
+		if pq.Len() < k {
+			pq.Push(v)
+			continue
+		}
+
+		if root, _ := pq.Peek(); less(root, v) {
+			pq.Pop()
+			pq.Push(v)
+		}
+	}
+
+	// Heap pops the least first, fill from the end
+	res := Make[T](k)
+	for i := k - 1; i >= 0; i-- {
+		v, _ := pq.Pop()
+		res.Set(i, v)
+	}
+
+	return res
+}
+
+// NthElement returns the element at index n of sorted s via quickselect (average O(n)).
+// It works on a clone, s is not reordered
+func NthElement[T cmp.Ordered](s Slice[T], n int) (T, bool) {
+	if n < 0 || n >= s.Len() {
+		var zero T
+		return zero, false
+	}
+
+	work := Make[T](s.Len())
+	Copy(work, s)
+
+	low, high := 0, work.Len()
+	for {
+		pivot := work.Get(low + rand.Intn(high-low))
+
+		// Three-way partition: [low, lt) < pivot, [lt, gt) == pivot, [gt, high) > pivot
+		lt, i, gt := low, low, high
+		for i < gt {
+			v := work.Get(i)
+			switch {
+			case cmp.Less(v, pivot):
+				work.swap(i, lt)
+				lt++
+				i++
+			case cmp.Less(pivot, v):
+				gt--
+				work.swap(i, gt)
+			default:
+				i++
+			}
+		}
+
+		switch {
+		case n < lt:
+			high = lt
+		case n >= gt:
+			low = gt
+		default:
+			return work.Get(n), true
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package slice
+
+import (
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+)
+
+type person struct {
+	name string
+	age  int
+}
+
+func TestMinMaxBy(t *testing.T) {
+	people := New(person{"a", 30}, person{"b", 20}, person{"c", 40}, person{"d", 20}, person{"e", 40})
+	age := func(p person) int { return p.age }
+
+	if p, ok := MinBy(people, age); !ok || p.name != "b" {
+		t.Fatalf("MinBy = %v, %v, want b (first on ties)", p, ok)
+	}
+	if p, ok := MaxBy(people, age); !ok || p.name != "c" {
+		t.Fatalf("MaxBy = %v, %v, want c (first on ties)", p, ok)
+	}
+
+	if _, ok := MinBy(New[person](), age); ok {
+		t.Fatal("MinBy on empty input succeeded")
+	}
+	if _, ok := MaxBy(New[person](), age); ok {
+		t.Fatal("MaxBy on empty input succeeded")
+	}
+}
+
+func TestClamp(t *testing.T) {
+	s := New(-5, 0, 3, 10, 11)
+	assertElems(t, Clamp(s, 0, 10), 0, 0, 3, 10, 10)
+	assertElems(t, s, -5, 0, 3, 10, 11)
+
+	assertPanics(t, func() { Clamp(s, 10, 0) })
+}
+
+func TestIndexOfMinMax(t *testing.T) {
+	s := New(3, 1, 4, 1, 5)
+	if got := IndexOfMax(s); got != 4 {
+		t.Fatalf("IndexOfMax = %d, want 4", got)
+	}
+	if got := IndexOfMin(s); got != 1 {
+		t.Fatalf("IndexOfMin = %d, want 1 (first on ties)", got)
+	}
+
+	ties := New(2, 7, 7, 2)
+	if IndexOfMax(ties) != 1 || IndexOfMin(ties) != 0 {
+		t.Fatal("ties must return the first index")
+	}
+
+	if IndexOfMax(New[int]()) != -1 || IndexOfMin(New[int]()) != -1 {
+		t.Fatal("empty input must return -1")
+	}
+}
+
+func TestSortBy(t *testing.T) {
+	people := New(person{"a", 30}, person{"b", 20}, person{"c", 30}, person{"d", 10}, person{"e", 20})
+	SortBy(people, func(p person) int { return p.age })
+
+	// Stable: equal ages keep their order
+	assertElems(t, people, person{"d", 10}, person{"b", 20}, person{"e", 20}, person{"a", 30}, person{"c", 30})
+
+	names := New("bb", "a", "ccc")
+	SortBy(names, func(s string) int { return -len(s) })
+	assertElems(t, names, "ccc", "bb", "a")
+}
+
+// uncachedSorter calls key on every comparison
+type uncachedSorter struct {
+	elems Slice[string]
+	key   func(string) string
+}
+
+func (us uncachedSorter) Len() int { return us.elems.Len() }
+func (us uncachedSorter) Less(i, j int) bool {
+	return us.key(us.elems.Get(i)) < us.key(us.elems.Get(j))
+}
+func (us uncachedSorter) Swap(i, j int) { us.elems.swap(i, j) }
+
+func sortByBenchInput() Slice[string] {
+	r := rand.New(rand.NewSource(1))
+	return Generate(1000, func(int) string { return strings.Repeat(string(rune('A'+r.Intn(26))), 50) })
+}
+
+var expensiveKey = strings.ToLower
+
+func BenchmarkSortBy(b *testing.B) {
+	input := sortByBenchInput()
+	for i := 0; i < b.N; i++ {
+		s := input.CloneWithCap(0)
+		SortBy(s, expensiveKey)
+	}
+}
+
+func BenchmarkSortByUncached(b *testing.B) {
+	input := sortByBenchInput()
+	for i := 0; i < b.N; i++ {
+		s := input.CloneWithCap(0)
+		sort.Stable(uncachedSorter{s, expensiveKey})
+	}
+}
+
+func randomInts(r *rand.Rand, n, max int) Slice[int] {
+	return Generate(n, func(int) int { return r.Intn(max) })
+}
+
+func TestTopK(t *testing.T) {
+	s := New(5, 1, 4, 2, 3)
+	assertElems(t, TopK(s, 0))
+	assertElems(t, TopK(s, -1))
+	assertElems(t, TopK(s, 1), 5)
+	assertElems(t, TopK(s, 3), 5, 4, 3)
+	assertElems(t, TopK(s, s.Len()), 5, 4, 3, 2, 1)
+	assertElems(t, TopK(s, 10), 5, 4, 3, 2, 1)
+	assertElems(t, s, 5, 1, 4, 2, 3)
+	assertElems(t, TopK(New[int](), 3))
+}
+
+func TestTopKRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		s := randomInts(r, r.Intn(50), 20)
+		before := toBuiltin(s)
+		k := r.Intn(s.Len() + 5)
+
+		want := toBuiltin(s)
+		sort.Sort(sort.Reverse(sort.IntSlice(want)))
+		if k < len(want) {
+			want = want[:k]
+		}
+		assertElems(t, TopK(s, k), want...)
+		assertElems(t, s, before...)
+	}
+}
+
+func TestBottomK(t *testing.T) {
+	s := New(5, 1, 4, 2, 3)
+	assertElems(t, BottomK(s, 0))
+	assertElems(t, BottomK(s, -1))
+	assertElems(t, BottomK(s, 1), 1)
+	assertElems(t, BottomK(s, 3), 1, 2, 3)
+	assertElems(t, BottomK(s, s.Len()), 1, 2, 3, 4, 5)
+	assertElems(t, BottomK(s, 10), 1, 2, 3, 4, 5)
+	assertElems(t, s, 5, 1, 4, 2, 3)
+	assertElems(t, BottomK(New[int](), 3))
+}
+
+func TestBottomKRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	for i := 0; i < 200; i++ {
+		s := randomInts(r, r.Intn(50), 20)
+		before := toBuiltin(s)
+		k := r.Intn(s.Len() + 5)
+
+		want := toBuiltin(s)
+		sort.Ints(want)
+		if k < len(want) {
+			want = want[:k]
+		}
+		assertElems(t, BottomK(s, k), want...)
+		assertElems(t, s, before...)
+	}
+}
+
+func TestNthElement(t *testing.T) {
+	s := New(5, 1, 4, 2, 3)
+	for n, want := range []int{1, 2, 3, 4, 5} {
+		if got, ok := NthElement(s, n); !ok || got != want {
+			t.Fatalf("NthElement(%d) = %d, %v; want %d, true", n, got, ok, want)
+		}
+	}
+	for _, n := range []int{-1, s.Len()} {
+		if _, ok := NthElement(s, n); ok {
+			t.Fatalf("NthElement(%d) reported ok for out-of-range n", n)
+		}
+	}
+	if _, ok := NthElement(New[int](), 0); ok {
+		t.Fatal("NthElement on empty slice reported ok")
+	}
+	assertElems(t, s, 5, 1, 4, 2, 3)
+}
+
+func TestNthElementRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	for i := 0; i < 200; i++ {
+		s := randomInts(r, 1+r.Intn(50), 10)
+		before := toBuiltin(s)
+		sorted := toBuiltin(s)
+		sort.Ints(sorted)
+
+		n := r.Intn(s.Len())
+		if got, ok := NthElement(s, n); !ok || got != sorted[n] {
+			t.Fatalf("NthElement(%v, %d) = %d, %v; want %d, true", before, n, got, ok, sorted[n])
+		}
+		assertElems(t, s, before...)
+	}
+}
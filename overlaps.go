@@ -0,0 +1,25 @@
+package slice
+
+import "unsafe"
+
+// Overlaps reports whether a[0:Cap()] and b[0:Cap()] share memory of the same backing array,
+// so a Set or an in-capacity Append on one may be visible in the other.
+// Nil, zero capacity and zero-size element slices never overlap
+func Overlaps[T any](a, b Slice[T]) bool {
+	if a.Cap() == 0 || b.Cap() == 0 {
+		return false
+	}
+
+	var zero T
+	size := unsafe.Sizeof(zero)
+	if size == 0 {
+		return false
+	}
+
+	aStart := uintptr(unsafe.Pointer(unsafe.SliceData(*a.array)))
+	bStart := uintptr(unsafe.Pointer(unsafe.SliceData(*b.array)))
+	aEnd := aStart + uintptr(a.Cap())*size
+	bEnd := bStart + uintptr(b.Cap())*size
+
+	return aStart < bEnd && bStart < aEnd
+}
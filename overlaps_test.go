@@ -0,0 +1,31 @@
+package slice
+
+import "testing"
+
+func TestOverlaps(t *testing.T) {
+	parent := New(1, 2, 3, 4, 5, 6)
+
+	if !Overlaps(parent, parent) {
+		t.Fatal("slice does not overlap itself")
+	}
+	if !Overlaps(parent.Sliced(0, 3), parent.Sliced(2, 4)) {
+		t.Fatal("overlapping views")
+	}
+
+	// Disjoint lengths, but appending to the first one writes into the second
+	if !Overlaps(parent.Sliced(0, 2), parent.Sliced(2, 4)) {
+		t.Fatal("capacity of the first view covers the second")
+	}
+	if Overlaps(parent.Sliced(0, 2, 2), parent.Sliced(2, 4)) {
+		t.Fatal("capacity-limited views must not overlap")
+	}
+
+	if Overlaps(parent, New(1, 2, 3, 4, 5, 6)) {
+		t.Fatal("independent slices overlap")
+	}
+
+	var nilS Slice[int]
+	if Overlaps(nilS, parent) || Overlaps(parent, nilS) {
+		t.Fatal("nil slice overlaps")
+	}
+}
@@ -0,0 +1,28 @@
+package slice
+
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// MapKeys transforms the First component of each pair
+func MapKeys[K, V, K2 any](s Slice[Pair[K, V]], f func(K) K2) Slice[Pair[K2, V]] {
+	res := Make[Pair[K2, V]](s.Len())
+	for i := 0; i < s.Len(); i++ {
+		p := s.Get(i)
+		res.Set(i, Pair[K2, V]{f(p.First), p.Second})
+	}
+
+	return res
+}
+
+// MapValues transforms the Second component of each pair
+func MapValues[K, V, V2 any](s Slice[Pair[K, V]], f func(V) V2) Slice[Pair[K, V2]] {
+	res := Make[Pair[K, V2]](s.Len())
+	for i := 0; i < s.Len(); i++ {
+		p := s.Get(i)
+		res.Set(i, Pair[K, V2]{p.First, f(p.Second)})
+	}
+
+	return res
+}
@@ -0,0 +1,18 @@
+package slice
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMapKeysValues(t *testing.T) {
+	s := New(Pair[string, int]{"a", 1}, Pair[string, int]{"b", 2})
+
+	keys := MapKeys(s, strings.ToUpper)
+	assertElems(t, keys, Pair[string, int]{"A", 1}, Pair[string, int]{"B", 2})
+
+	values := MapValues(s, func(v int) float64 { return float64(v) / 2 })
+	assertElems(t, values, Pair[string, float64]{"a", 0.5}, Pair[string, float64]{"b", 1})
+
+	assertElems(t, MapKeys(New[Pair[string, int]](), strings.ToUpper))
+}
@@ -0,0 +1,61 @@
+package slice
+
+import "sync"
+
+// MapChunksParallel applies f to chunks of chunkSize on up to workers goroutines
+// and concatenates the results in original order. f must be pure, chunks are views of s
+func (s Slice[T]) MapChunksParallel(chunkSize, workers int, f func(Slice[T]) Slice[T]) Slice[T] {
+	if chunkSize <= 0 {
+		panic("slice.MapChunksParallel: non-positive chunk size")
+	}
+	if workers <= 0 {
+		panic("slice.MapChunksParallel: non-positive workers count")
+	}
+
+	chunks := 0
+	if s.Len() > 0 {
+		chunks = (s.Len()-1)/chunkSize + 1
+	}
+
+	// Builtin slice: Slice[Slice[T]] inside a Slice[T] method is an instantiation cycle.
+	// Each worker writes only its own result slots
+	results := make([]Slice[T], chunks)
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers && w < chunks; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for i := range jobs {
+				low := i * chunkSize
+				high := low + chunkSize
+				if high > s.Len() {
+					high = s.Len()
+				}
+
+				results[i] = f(s.Sliced(low, high, high))
+			}
+		}()
+	}
+
+	for i := 0; i < chunks; i++ {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	resLen := 0
+	for _, r := range results {
+		resLen += r.Len()
+	}
+
+	res := Make[T](resLen)
+	offset := 0
+	for _, r := range results {
+		offset += Copy(res.Sliced(offset, resLen), r)
+	}
+
+	return res
+}
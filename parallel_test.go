@@ -0,0 +1,57 @@
+package slice
+
+import "testing"
+
+func sequentialChunkMap(s Slice[int], chunkSize int, f func(Slice[int]) Slice[int]) Slice[int] {
+	res := Make[int](0)
+	for chunk := range s.Chunks(chunkSize) {
+		mapped := f(chunk)
+		for i := 0; i < mapped.Len(); i++ {
+			res = Append(res, mapped.Get(i))
+		}
+	}
+
+	return res
+}
+
+// Pure, changes chunk lengths to check ordering of uneven results
+func expandChunk(c Slice[int]) Slice[int] {
+	return Transform(c, func(v int, emit func(int)) {
+		emit(v * 2)
+		if v%10 == 0 {
+			emit(-v)
+		}
+	})
+}
+
+func TestMapChunksParallel(t *testing.T) {
+	s := Iota(1003)
+
+	for _, tc := range []struct{ chunkSize, workers int }{
+		{10, 4},
+		{1, 8},
+		{7, 1},
+		{2000, 3},
+		{3, 1000},
+	} {
+		got := s.MapChunksParallel(tc.chunkSize, tc.workers, expandChunk)
+		want := sequentialChunkMap(s, tc.chunkSize, expandChunk)
+		assertElems(t, got, toBuiltin(want)...)
+	}
+
+	assertElems(t, New[int]().MapChunksParallel(3, 2, expandChunk))
+
+	assertPanics(t, func() { s.MapChunksParallel(0, 1, expandChunk) })
+	assertPanics(t, func() { s.MapChunksParallel(1, 0, expandChunk) })
+}
+
+// Meant for go test -race: many workers reading s and writing results at once
+func TestMapChunksParallelRace(t *testing.T) {
+	s := Iota(10000)
+
+	got := s.MapChunksParallel(16, 32, func(c Slice[int]) Slice[int] {
+		return MapIndex(c, func(_, v int) int { return v + 1 })
+	})
+
+	assertElems(t, got, toBuiltin(Range(1, 10001, 1))...)
+}
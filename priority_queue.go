@@ -0,0 +1,78 @@
+package slice
+
+// PriorityQueue binary heap, Pop returns the element that is less than all others
+type PriorityQueue[T any] struct {
+	elems Slice[T]
+	less  func(a, b T) bool
+}
+
+func NewPriorityQueue[T any](less func(a, b T) bool) *PriorityQueue[T] {
+	return &PriorityQueue[T]{less: less}
+}
+
+func (pq *PriorityQueue[T]) Push(val T) {
+	pq.elems = Append(pq.elems, val)
+	pq.siftUp(pq.elems.Len() - 1)
+}
+
+func (pq *PriorityQueue[T]) Pop() (T, bool) {
+	var zero T
+	if pq.elems.Len() == 0 {
+		return zero, false
+	}
+
+	last := pq.elems.Len() - 1
+	top := pq.elems.Get(0)
+	pq.elems.swap(0, last)
+	pq.elems.Set(last, zero) // Release reference
+	pq.elems = pq.elems.Sliced(0, last)
+	pq.siftDown(0)
+
+	return top, true
+}
+
+func (pq *PriorityQueue[T]) Peek() (T, bool) {
+	if pq.elems.Len() == 0 {
+		var zero T
+		return zero, false
+	}
+
+	return pq.elems.Get(0), true
+}
+
+func (pq *PriorityQueue[T]) Len() int {
+	return pq.elems.Len()
+}
+
+func (pq *PriorityQueue[T]) siftUp(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.less(pq.elems.Get(i), pq.elems.Get(parent)) {
+			return
+		}
+
+		pq.elems.swap(i, parent)
+		i = parent
+	}
+}
+
+func (pq *PriorityQueue[T]) siftDown(i int) {
+	n := pq.elems.Len()
+	for {
+		smallest := i
+		left, right := 2*i+1, 2*i+2
+		if left < n && pq.less(pq.elems.Get(left), pq.elems.Get(smallest)) {
+			smallest = left
+		}
+		if right < n && pq.less(pq.elems.Get(right), pq.elems.Get(smallest)) {
+			smallest = right
+		}
+
+		if smallest == i {
+			return
+		}
+
+		pq.elems.swap(i, smallest)
+		i = smallest
+	}
+}
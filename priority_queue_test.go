@@ -0,0 +1,61 @@
+package slice
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	minPQ := NewPriorityQueue(func(a, b int) bool { return a < b })
+	maxPQ := NewPriorityQueue(func(a, b int) bool { return a > b })
+	for _, v := range []int{5, 1, 4, 1, 3} {
+		minPQ.Push(v)
+		maxPQ.Push(v)
+	}
+
+	if v, ok := minPQ.Peek(); !ok || v != 1 {
+		t.Fatalf("Peek() = %v, %v, want 1, true", v, ok)
+	}
+	if minPQ.Len() != 5 {
+		t.Fatalf("Len() = %d, want 5", minPQ.Len())
+	}
+
+	for _, want := range []int{1, 1, 3, 4, 5} {
+		if v, _ := minPQ.Pop(); v != want {
+			t.Fatalf("min Pop() = %d, want %d", v, want)
+		}
+	}
+	for _, want := range []int{5, 4, 3, 1, 1} {
+		if v, _ := maxPQ.Pop(); v != want {
+			t.Fatalf("max Pop() = %d, want %d", v, want)
+		}
+	}
+
+	if _, ok := minPQ.Pop(); ok {
+		t.Fatal("Pop() on empty queue succeeded")
+	}
+	if _, ok := minPQ.Peek(); ok {
+		t.Fatal("Peek() on empty queue succeeded")
+	}
+}
+
+func TestPriorityQueueRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for iter := 0; iter < 200; iter++ {
+		pq := NewPriorityQueue(func(a, b int) bool { return a < b })
+		elems := make([]int, r.Intn(200))
+		for i := range elems {
+			elems[i] = r.Intn(50)
+			pq.Push(elems[i])
+		}
+		sort.Ints(elems)
+
+		for _, want := range elems {
+			if v, _ := pq.Pop(); v != want {
+				t.Fatalf("Pop() = %d, want %d", v, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package slice
+
+const queueCompactThreshold = 32
+
+// Queue FIFO, zero value is ready to use.
+// Dequeue is amortized O(1): the consumed head is compacted away once it takes at least half of the storage
+type Queue[T any] struct {
+	elems Slice[T]
+	head  int
+}
+
+func (q *Queue[T]) Enqueue(val T) {
+	q.elems = Append(q.elems, val)
+}
+
+func (q *Queue[T]) Dequeue() (T, bool) {
+	var zero T
+	if q.Len() == 0 {
+		return zero, false
+	}
+
+	val := q.elems.Get(q.head)
+	q.elems.Set(q.head, zero) // Release reference
+	q.head++
+
+	switch {
+	case q.head == q.elems.Len():
+		q.elems = q.elems.Sliced(0, 0)
+		q.head = 0
+	case q.head >= queueCompactThreshold && q.head >= q.elems.Len()/2:
+		q.compact()
+	}
+
+	return val, true
+}
+
+func (q *Queue[T]) Peek() (T, bool) {
+	if q.Len() == 0 {
+		var zero T
+		return zero, false
+	}
+
+	return q.elems.Get(q.head), true
+}
+
+func (q *Queue[T]) Len() int {
+	return q.elems.Len() - q.head
+}
+
+func (q *Queue[T]) compact() {
+	n := Copy(q.elems, q.elems.Sliced(q.head, q.elems.Len()))
+
+	var zero T
+	for i := n; i < q.elems.Len(); i++ {
+		q.elems.Set(i, zero)
+	}
+
+	q.elems = q.elems.Sliced(0, n)
+	q.head = 0
+}
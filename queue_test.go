@@ -0,0 +1,60 @@
+package slice
+
+import "testing"
+
+func TestQueue(t *testing.T) {
+	var q Queue[int]
+	if _, ok := q.Dequeue(); ok {
+		t.Fatal("Dequeue() on empty queue succeeded")
+	}
+	if _, ok := q.Peek(); ok {
+		t.Fatal("Peek() on empty queue succeeded")
+	}
+
+	for i := 1; i <= 3; i++ {
+		q.Enqueue(i)
+	}
+
+	if v, ok := q.Peek(); !ok || v != 1 {
+		t.Fatalf("Peek() = %v, %v, want 1, true", v, ok)
+	}
+
+	for want := 1; want <= 3; want++ {
+		if v, ok := q.Dequeue(); !ok || v != want {
+			t.Fatalf("Dequeue() = %v, %v, want %v, true", v, ok, want)
+		}
+	}
+
+	if q.Len() != 0 {
+		t.Fatalf("Len() = %d, want 0", q.Len())
+	}
+}
+
+func TestQueueStress(t *testing.T) {
+	const size = 100
+
+	var q Queue[int]
+	for i := 0; i < size; i++ {
+		q.Enqueue(i)
+	}
+
+	want := 0
+	for i := size; i < 1_000_000; i++ {
+		q.Enqueue(i)
+
+		v, ok := q.Dequeue()
+		if !ok || v != want {
+			t.Fatalf("Dequeue() = %v, %v, want %v, true", v, ok, want)
+		}
+		want++
+	}
+
+	if q.Len() != size {
+		t.Fatalf("Len() = %d, want %d", q.Len(), size)
+	}
+
+	// Storage must stay bounded by the live elements, not by the total enqueued
+	if c := q.elems.Cap(); c > 4*size {
+		t.Fatalf("storage capacity %d grew unbounded", c)
+	}
+}
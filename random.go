@@ -0,0 +1,74 @@
+package slice
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Sample returns k distinct-position elements chosen at random (partial Fisher–Yates over a copy).
+// k is clamped into [0, Len()]
+func (s Slice[T]) Sample(k int, r *rand.Rand) Slice[T] {
+	if k < 0 {
+		k = 0
+	}
+	if k > s.Len() {
+		k = s.Len()
+	}
+
+	pool := Make[T](s.Len())
+	Copy(pool, s)
+
+	for i := 0; i < k; i++ {
+		pool.swap(i, i+r.Intn(pool.Len()-i))
+	}
+
+	return pool.Sliced(0, k, k)
+}
+
+// Choice picks an element with probability proportional to its weight.
+// Weights must be finite and non-negative (NaN and Inf panic).
+// Returns false for empty input or when all weights are zero
+func Choice[T any](s Slice[T], weights Slice[float64], r *rand.Rand) (T, bool) {
+	var zero T
+	if weights.Len() != s.Len() {
+		panic("slice.Choice: weights length mismatch")
+	}
+
+	total := 0.0
+	for i := 0; i < weights.Len(); i++ {
+		w := weights.Get(i)
+		if !(w >= 0) {
+			panic("slice.Choice: negative or NaN weight")
+		}
+		if math.IsInf(w, 1) {
+			panic("slice.Choice: infinite weight")
+		}
+		total += w
+	}
+
+	if math.IsInf(total, 1) {
+		panic("slice.Choice: weights sum overflows")
+	}
+
+	if total == 0 {
+		return zero, false
+	}
+
+	target := r.Float64() * total
+	last := -1
+	for i := 0; i < weights.Len(); i++ {
+		w := weights.Get(i)
+		if w == 0 {
+			continue
+		}
+
+		last = i
+		if target < w {
+			return s.Get(i), true
+		}
+		target -= w
+	}
+
+	// Float rounding case
+	return s.Get(last), true
+}
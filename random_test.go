@@ -0,0 +1,68 @@
+package slice
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestSample(t *testing.T) {
+	s := Iota(20)
+
+	a := s.Sample(5, rand.New(rand.NewSource(42)))
+	b := s.Sample(5, rand.New(rand.NewSource(42)))
+	assertElems(t, a, toBuiltin(b)...)
+
+	if a.Len() != 5 || CountDistinct(a) != 5 {
+		t.Fatalf("Sample = %v, want 5 distinct elements", a)
+	}
+	assertElems(t, s, toBuiltin(Iota(20))...)
+
+	r := rand.New(rand.NewSource(1))
+	if res := s.Sample(100, r); res.Len() != 20 {
+		t.Fatalf("k > Len(): got %d elements, want 20", res.Len())
+	}
+	if res := s.Sample(-1, r); res.Len() != 0 {
+		t.Fatalf("negative k: got %d elements, want 0", res.Len())
+	}
+}
+
+func TestChoice(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	s := New("a", "b", "c")
+	weights := New(1.0, 0, 3.0)
+
+	const draws = 100000
+	counts := make(map[string]int)
+	for i := 0; i < draws; i++ {
+		v, ok := Choice(s, weights, r)
+		if !ok {
+			t.Fatal("Choice failed")
+		}
+		counts[v]++
+	}
+
+	if counts["b"] != 0 {
+		t.Fatalf("zero-weight element chosen %d times", counts["b"])
+	}
+	if share := float64(counts["a"]) / draws; math.Abs(share-0.25) > 0.01 {
+		t.Fatalf("share of a = %v, want about 0.25", share)
+	}
+}
+
+func TestChoiceInvalid(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	if _, ok := Choice(New[int](), New[float64](), r); ok {
+		t.Fatal("Choice on empty input succeeded")
+	}
+	if _, ok := Choice(New(1, 2), New(0.0, 0.0), r); ok {
+		t.Fatal("Choice with zero weights succeeded")
+	}
+
+	assertPanics(t, func() { Choice(New(1, 2), New(1.0), r) })
+	assertPanics(t, func() { Choice(New(1, 2), New(1.0, -1.0), r) })
+	assertPanics(t, func() { Choice(New(1, 2, 3), New(1.0, math.NaN(), 1.0), r) })
+	assertPanics(t, func() { Choice(New(1, 2), New(1.0, math.Inf(1)), r) })
+	assertPanics(t, func() { Choice(New(1, 2), New(math.MaxFloat64, math.MaxFloat64), r) })
+}
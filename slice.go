@@ -37,6 +37,15 @@ func Make[T any](size ...int) Slice[T] {
 	}
 }
 
+// WithCapacity returns an empty slice with reserved capacity, same as Make(0, capacity)
+func WithCapacity[T any](capacity int) Slice[T] {
+	if capacity < 0 {
+		panic("slice.WithCapacity: negative slice capacity")
+	}
+
+	return Make[T](0, capacity)
+}
+
 func extractMakeIndexes(size ...int) (length, capacity int, err error) {
 	// Check args count
 	if len(size) == 0 {
@@ -74,6 +83,33 @@ func extractMakeIndexes(size ...int) (length, capacity int, err error) {
 	return
 }
 
+func Repeat[T any](val T, count int) Slice[T] {
+	if count < 0 {
+		panic("slice.Repeat: negative count")
+	}
+
+	s := Make[T](count)
+	for i := 0; i < count; i++ {
+		s.Set(i, val)
+	}
+
+	return s
+}
+
+// Generate with gen(i) for each i in [0, n)
+func Generate[T any](n int, gen func(i int) T) Slice[T] {
+	if n < 0 {
+		panic("slice.Generate: negative n")
+	}
+
+	s := Make[T](n)
+	for i := 0; i < n; i++ {
+		s.Set(i, gen(i))
+	}
+
+	return s
+}
+
 func (s Slice[T]) IsNil() bool {
 	return s.array == nil
 }
@@ -86,6 +122,34 @@ func (s Slice[T]) Cap() int {
 	return s.capacity
 }
 
+// Validate checks the struct invariants against the backing array, for tests and debugging
+func (s Slice[T]) Validate() error {
+	if s.length < 0 {
+		return errors.New("negative slice length")
+	}
+
+	if s.length > s.capacity {
+		return fmt.Errorf("slice length %d greater than capacity %d", s.length, s.capacity)
+	}
+
+	if s.IsNil() {
+		if s.capacity != 0 {
+			return fmt.Errorf("nil slice with capacity %d", s.capacity)
+		}
+		return nil
+	}
+
+	if arrayCap := cap(*s.array); s.capacity != arrayCap {
+		return fmt.Errorf("slice capacity %d differs from backing array capacity %d", s.capacity, arrayCap)
+	}
+
+	if arrayLen := len(*s.array); s.length > arrayLen {
+		return fmt.Errorf("slice length %d greater than backing array length %d", s.length, arrayLen)
+	}
+
+	return nil
+}
+
 func (s Slice[T]) Get(idx int) T {
 	if idx < 0 || idx >= s.Len() {
 		panic("slice.Get: index out of range")
@@ -108,11 +172,17 @@ func (s Slice[T]) Sliced(indexes ...int) Slice[T] {
 		panic("slice.Sliced: " + err.Error())
 	}
 
+	// Like nil[0:0] in Go
+	if s.IsNil() {
+		return s
+	}
+
+	// Take length & capacity from the builtin slice expression, so Cap() can't drift from it
 	array := (*s.array)[low:high:newCap]
 	return Slice[T]{
 		array:    &array,
-		length:   high - low,
-		capacity: newCap - low,
+		length:   len(array),
+		capacity: cap(array),
 	}
 }
 
@@ -143,11 +213,20 @@ func (s Slice[T]) extractSlicedIndexes(indexes ...int) (low, high, newCap int, e
 	return
 }
 
+// Append reuses the backing array of s if capacity allows, so it may overwrite
+// elements of other slices sharing that array (e.g. made with Sliced)
 func Append[T any](s Slice[T], elems ...T) Slice[T] {
+	res, _ := AppendSafe(s, elems...)
+	return res
+}
+
+// AppendSafe is Append that also reports whether the backing array of s was reused
+func AppendSafe[T any](s Slice[T], elems ...T) (Slice[T], bool) {
 	resLen := s.Len() + len(elems)
 
 	var res Slice[T]
-	if resLen <= s.Cap() {
+	reused := resLen <= s.Cap()
+	if reused {
 		res = s.Sliced(0, resLen)
 	} else {
 		res = growSlice(s, resLen)
@@ -157,6 +236,20 @@ func Append[T any](s Slice[T], elems ...T) Slice[T] {
 		res.Set(i, elems[i-s.Len()])
 	}
 
+	return res, reused
+}
+
+// AppendClone is Append that always allocates a new backing array, s's memory is never touched
+func AppendClone[T any](s Slice[T], elems ...T) Slice[T] {
+	resLen := s.Len() + len(elems)
+
+	// Size from the length: spare capacity of s says nothing about the clone
+	res := Make[T](resLen, nextSliceCapacity(resLen, s.Len()))
+	Copy(res, s)
+	for i := s.Len(); i < resLen; i++ {
+		res.Set(i, elems[i-s.Len()])
+	}
+
 	return res
 }
 
@@ -236,3 +329,836 @@ func (s Slice[T]) String() string {
 
 	return sb.String()
 }
+
+// Tap calls f with s (read-only use) and returns s as is, for debugging in pipelines
+func (s Slice[T]) Tap(f func(Slice[T])) Slice[T] {
+	f(s)
+	return s
+}
+
+// SplitFunc starts a new group whenever boundary(prev, cur) is true.
+// Groups are views of s (capacity limited to group length)
+func SplitFunc[T any](s Slice[T], boundary func(prev, cur T) bool) Slice[Slice[T]] {
+	res := Make[Slice[T]](0)
+	if s.Len() == 0 {
+		return res
+	}
+
+	low := 0
+	for i := 1; i < s.Len(); i++ {
+		if boundary(s.Get(i-1), s.Get(i)) {
+			res = Append(res, s.Sliced(low, i, i))
+			low = i
+		}
+	}
+	res = Append(res, s.Sliced(low, s.Len(), s.Len()))
+
+	return res
+}
+
+// GroupConsecutive groups maximal runs of adjacent equal elements (as views of s)
+func GroupConsecutive[T comparable](s Slice[T]) Slice[Slice[T]] {
+	return SplitFunc(s, func(prev, cur T) bool {
+		return prev != cur
+	})
+}
+
+// RunLengthEncode returns (value, run length) pairs for each run of equal elements
+func RunLengthEncode[T comparable](s Slice[T]) Slice[Pair[T, int]] {
+	groups := GroupConsecutive(s)
+
+	res := Make[Pair[T, int]](groups.Len())
+	for i := 0; i < groups.Len(); i++ {
+		g := groups.Get(i)
+		res.Set(i, Pair[T, int]{g.Get(0), g.Len()})
+	}
+
+	return res
+}
+
+// RunLengthDecode expands (value, count) pairs, pairs with count <= 0 are skipped
+func RunLengthDecode[T any](s Slice[Pair[T, int]]) Slice[T] {
+	resLen := 0
+	for i := 0; i < s.Len(); i++ {
+		if cnt := s.Get(i).Second; cnt > 0 {
+			resLen += cnt
+		}
+	}
+
+	res := Make[T](0, resLen)
+	for i := 0; i < s.Len(); i++ {
+		p := s.Get(i)
+		for j := 0; j < p.Second; j++ {
+			res = Append(res, p.First)
+		}
+	}
+
+	return res
+}
+
+// SplitAt returns s[:idx] and s[idx:] with idx clamped into [0, Len()].
+// Both share the backing array with s, the first one is capacity-limited to idx
+func (s Slice[T]) SplitAt(idx int) (Slice[T], Slice[T]) {
+	if idx < 0 {
+		idx = 0
+	}
+	if idx > s.Len() {
+		idx = s.Len()
+	}
+
+	return s.Sliced(0, idx, idx), s.Sliced(idx, s.Len())
+}
+
+// Span returns the longest prefix satisfying pred and the rest, same sharing as SplitAt
+func (s Slice[T]) Span(pred func(T) bool) (Slice[T], Slice[T]) {
+	i := 0
+	for i < s.Len() && pred(s.Get(i)) {
+		i++
+	}
+
+	return s.SplitAt(i)
+}
+
+// Transpose of a matrix as slice of rows, panics on rows of differing lengths
+func Transpose[T any](m Slice[Slice[T]]) Slice[Slice[T]] {
+	if m.Len() == 0 {
+		return Make[Slice[T]](0)
+	}
+
+	cols := m.Get(0).Len()
+	for i := 1; i < m.Len(); i++ {
+		if m.Get(i).Len() != cols {
+			panic("slice.Transpose: rows of differing lengths")
+		}
+	}
+
+	res := Make[Slice[T]](cols)
+	for j := 0; j < cols; j++ {
+		col := Make[T](m.Len())
+		for i := 0; i < m.Len(); i++ {
+			col.Set(i, m.Get(i).Get(j))
+		}
+		res.Set(j, col)
+	}
+
+	return res
+}
+
+func (s Slice[T]) swap(i, j int) {
+	a, b := s.Get(i), s.Get(j)
+	s.Set(i, b)
+	s.Set(j, a)
+}
+
+func StartsWith[T comparable](s, prefix Slice[T]) bool {
+	if prefix.Len() > s.Len() {
+		return false
+	}
+
+	for i := 0; i < prefix.Len(); i++ {
+		if s.Get(i) != prefix.Get(i) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func EndsWith[T comparable](s, suffix Slice[T]) bool {
+	if suffix.Len() > s.Len() {
+		return false
+	}
+
+	offset := s.Len() - suffix.Len()
+	for i := 0; i < suffix.Len(); i++ {
+		if s.Get(offset+i) != suffix.Get(i) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IndexOfSubslice returns index of the first occurrence of sub in s or -1, O(n*m)
+func IndexOfSubslice[T comparable](s, sub Slice[T]) int {
+	for i := 0; i+sub.Len() <= s.Len(); i++ {
+		j := 0
+		for j < sub.Len() && s.Get(i+j) == sub.Get(j) {
+			j++
+		}
+
+		if j == sub.Len() {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func ContainsSubslice[T comparable](s, sub Slice[T]) bool {
+	return IndexOfSubslice(s, sub) >= 0
+}
+
+// CommonPrefix returns the longest common leading run of a and b as an independent slice
+func CommonPrefix[T comparable](a, b Slice[T]) Slice[T] {
+	res := Make[T](CommonPrefixLength(a, b))
+	Copy(res, a)
+
+	return res
+}
+
+func CommonPrefixLength[T comparable](a, b Slice[T]) int {
+	n := 0
+	for n < a.Len() && n < b.Len() && a.Get(n) == b.Get(n) {
+		n++
+	}
+
+	return n
+}
+
+// EqualUnordered compares a and b as multisets
+func EqualUnordered[T comparable](a, b Slice[T]) bool {
+	if a.Len() != b.Len() {
+		return false
+	}
+
+	counts := make(map[T]int, a.Len())
+	for i := 0; i < a.Len(); i++ {
+		counts[a.Get(i)]++
+	}
+
+	for i := 0; i < b.Len(); i++ {
+		v := b.Get(i)
+		if counts[v] == 0 {
+			return false
+		}
+		counts[v]--
+	}
+
+	return true
+}
+
+// Reject returns a new slice of elements not satisfying pred
+func Reject[T any](s Slice[T], pred func(T) bool) Slice[T] {
+	res := Make[T](0)
+	for i := 0; i < s.Len(); i++ {
+		if v := s.Get(i); !pred(v) {
+			res = Append(res, v)
+		}
+	}
+
+	return res
+}
+
+func CountBy[T any, K comparable](s Slice[T], key func(T) K) map[K]int {
+	res := make(map[K]int)
+	for i := 0; i < s.Len(); i++ {
+		res[key(s.Get(i))]++
+	}
+
+	return res
+}
+
+// DedupConsecutive removes adjacent duplicates (per eq) in place, zeroes the freed tail
+// and returns the shortened view of the same backing array
+func (s Slice[T]) DedupConsecutive(eq func(T, T) bool) Slice[T] {
+	if s.Len() < 2 {
+		return s
+	}
+
+	n := 1
+	for i := 1; i < s.Len(); i++ {
+		if v := s.Get(i); !eq(s.Get(n-1), v) {
+			s.Set(n, v)
+			n++
+		}
+	}
+
+	s.clearRange(n, s.Len())
+
+	return s.Sliced(0, n)
+}
+
+// clearRange zeroes s[from:to]
+func (s Slice[T]) clearRange(from, to int) {
+	var zero T
+	for i := from; i < to; i++ {
+		s.Set(i, zero)
+	}
+}
+
+// Resize to exactly newLen: dropped slots are zeroed when shrinking, new ones set to fill when growing
+func Resize[T any](s Slice[T], newLen int, fill T) Slice[T] {
+	if newLen < 0 {
+		panic("slice.Resize: negative length")
+	}
+
+	if newLen == s.Len() {
+		return s
+	}
+
+	if newLen < s.Len() {
+		s.clearRange(newLen, s.Len())
+		return s.Sliced(0, newLen)
+	}
+
+	var res Slice[T]
+	if newLen <= s.Cap() {
+		res = s.Sliced(0, newLen)
+	} else {
+		res = growSlice(s, newLen)
+	}
+
+	for i := s.Len(); i < newLen; i++ {
+		res.Set(i, fill)
+	}
+
+	return res
+}
+
+// PadRight appends copies of pad up to length, like Append it may reuse the backing array
+func PadRight[T any](s Slice[T], length int, pad T) Slice[T] {
+	if s.Len() >= length {
+		return s
+	}
+
+	return Resize(s, length, pad)
+}
+
+// PadLeft returns a new slice of length with copies of pad prepended
+func PadLeft[T any](s Slice[T], length int, pad T) Slice[T] {
+	if s.Len() >= length {
+		return s
+	}
+
+	padLen := length - s.Len()
+	res := Make[T](length)
+	for i := 0; i < padLen; i++ {
+		res.Set(i, pad)
+	}
+	Copy(res.Sliced(padLen, length), s)
+
+	return res
+}
+
+func CountDistinct[T comparable](s Slice[T]) int {
+	seen := make(map[T]struct{})
+	for i := 0; i < s.Len(); i++ {
+		seen[s.Get(i)] = struct{}{}
+	}
+
+	return len(seen)
+}
+
+// JoinFunc renders elements via toStr and joins them with sep
+func JoinFunc[T any](s Slice[T], sep string, toStr func(T) string) string {
+	if s.Len() == 0 {
+		return ""
+	}
+
+	strs := Make[string](s.Len())
+	size := len(sep) * (s.Len() - 1)
+	for i := 0; i < s.Len(); i++ {
+		str := toStr(s.Get(i))
+		strs.Set(i, str)
+		size += len(str)
+	}
+
+	var sb strings.Builder
+	sb.Grow(size)
+	sb.WriteString(strs.Get(0))
+	for i := 1; i < strs.Len(); i++ {
+		sb.WriteString(sep)
+		sb.WriteString(strs.Get(i))
+	}
+
+	return sb.String()
+}
+
+// ForEachWindow calls f for each sliding window of size.
+// Windows are views of s, f must not retain them
+func (s Slice[T]) ForEachWindow(size int, f func(Slice[T])) {
+	if size <= 0 {
+		panic("slice.ForEachWindow: non-positive size")
+	}
+
+	for i := 0; i+size <= s.Len(); i++ {
+		f(s.Sliced(i, i+size, i+size))
+	}
+}
+
+// RemoveFunc removes elements satisfying pred in place, zeroes the freed tail
+// and returns the shortened view with the count of removed elements
+func (s Slice[T]) RemoveFunc(pred func(T) bool) (Slice[T], int) {
+	n := 0
+	for i := 0; i < s.Len(); i++ {
+		if v := s.Get(i); !pred(v) {
+			s.Set(n, v)
+			n++
+		}
+	}
+
+	removed := s.Len() - n
+	if removed == 0 {
+		return s, 0
+	}
+
+	s.clearRange(n, s.Len())
+
+	return s.Sliced(0, n), removed
+}
+
+// EqualBy compares a and b element-wise by key, nil and empty slices are equal
+func EqualBy[T any, K comparable](a, b Slice[T], key func(T) K) bool {
+	if a.Len() != b.Len() {
+		return false
+	}
+
+	for i := 0; i < a.Len(); i++ {
+		if key(a.Get(i)) != key(b.Get(i)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FillFunc sets s[i] = gen(i) for each i in [0, Len())
+func (s Slice[T]) FillFunc(gen func(i int) T) {
+	for i := 0; i < s.Len(); i++ {
+		s.Set(i, gen(i))
+	}
+}
+
+func MapIndex[T, U any](s Slice[T], f func(i int, v T) U) Slice[U] {
+	res := Make[U](s.Len())
+	for i := 0; i < s.Len(); i++ {
+		res.Set(i, f(i, s.Get(i)))
+	}
+
+	return res
+}
+
+func FilterIndex[T any](s Slice[T], pred func(i int, v T) bool) Slice[T] {
+	res := Make[T](0)
+	for i := 0; i < s.Len(); i++ {
+		if v := s.Get(i); pred(i, v) {
+			res = Append(res, v)
+		}
+	}
+
+	return res
+}
+
+func ReduceIndex[T, A any](s Slice[T], init A, f func(acc A, i int, v T) A) A {
+	acc := init
+	for i := 0; i < s.Len(); i++ {
+		acc = f(acc, i, s.Get(i))
+	}
+
+	return acc
+}
+
+// FindWithIndex returns index, value and true of the first element satisfying pred, or -1, zero value and false
+func (s Slice[T]) FindWithIndex(pred func(T) bool) (int, T, bool) {
+	for i := 0; i < s.Len(); i++ {
+		if v := s.Get(i); pred(v) {
+			return i, v, true
+		}
+	}
+
+	var zero T
+	return -1, zero, false
+}
+
+// IndexOfNth returns index of the n-th (1-based) occurrence of target, or -1 if there are fewer or n <= 0
+func IndexOfNth[T comparable](s Slice[T], target T, n int) int {
+	if n <= 0 {
+		return -1
+	}
+
+	for i := 0; i < s.Len(); i++ {
+		if s.Get(i) == target {
+			n--
+			if n == 0 {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
+
+// Move removes the element at from and reinserts it at to, shifting the elements in between
+func (s Slice[T]) Move(from, to int) {
+	if from < 0 || from >= s.Len() || to < 0 || to >= s.Len() {
+		panic("slice.Move: index out of range")
+	}
+
+	v := s.Get(from)
+	for i := from; i < to; i++ {
+		s.Set(i, s.Get(i+1))
+	}
+	for i := from; i > to; i-- {
+		s.Set(i, s.Get(i-1))
+	}
+	s.Set(to, v)
+}
+
+// FromMapValues collects values of m, order is unspecified
+func FromMapValues[K comparable, V any](m map[K]V) Slice[V] {
+	res := Make[V](0, len(m))
+	for _, v := range m {
+		res = Append(res, v)
+	}
+
+	return res
+}
+
+// FromMapKeys collects keys of m, order is unspecified
+func FromMapKeys[K comparable, V any](m map[K]V) Slice[K] {
+	res := Make[K](0, len(m))
+	for k := range m {
+		res = Append(res, k)
+	}
+
+	return res
+}
+
+// Apply threads s through ops left to right
+func (s Slice[T]) Apply(ops ...func(Slice[T]) Slice[T]) Slice[T] {
+	for _, op := range ops {
+		s = op(s)
+	}
+
+	return s
+}
+
+// IndicesWhere returns ascending indices of elements satisfying pred
+func (s Slice[T]) IndicesWhere(pred func(T) bool) Slice[int] {
+	res := Make[int](0)
+	for i := 0; i < s.Len(); i++ {
+		if pred(s.Get(i)) {
+			res = Append(res, i)
+		}
+	}
+
+	return res
+}
+
+// FirstN returns a copy of the first min(n, Len()) elements, negative n is treated as 0
+func (s Slice[T]) FirstN(n int) Slice[T] {
+	n = clampCount(n, s.Len())
+
+	res := Make[T](n)
+	Copy(res, s)
+
+	return res
+}
+
+// LastN returns a copy of the last min(n, Len()) elements, negative n is treated as 0
+func (s Slice[T]) LastN(n int) Slice[T] {
+	n = clampCount(n, s.Len())
+
+	res := Make[T](n)
+	Copy(res, s.Sliced(s.Len()-n, s.Len()))
+
+	return res
+}
+
+func clampCount(n, length int) int {
+	if n < 0 {
+		return 0
+	}
+	if n > length {
+		return length
+	}
+
+	return n
+}
+
+// EveryNth returns a copy of elements at indices 0, n, 2n, ...
+func (s Slice[T]) EveryNth(n int) Slice[T] {
+	if n <= 0 {
+		panic("slice.EveryNth: non-positive n")
+	}
+
+	resLen := 0
+	if s.Len() > 0 {
+		resLen = (s.Len()-1)/n + 1
+	}
+
+	res := Make[T](resLen)
+	for i := 0; i < res.Len(); i++ {
+		res.Set(i, s.Get(i*n))
+	}
+
+	return res
+}
+
+// Rotated returns a copy of s rotated left by k (negative k rotates right): res[i] = s[(i+k) mod Len()]
+func (s Slice[T]) Rotated(k int) Slice[T] {
+	res := Make[T](s.Len())
+	if s.Len() == 0 {
+		return res
+	}
+
+	k = normalizeShift(k, s.Len())
+	Copy(res, s.Sliced(k, s.Len()))
+	Copy(res.Sliced(s.Len()-k, s.Len()), s)
+
+	return res
+}
+
+// normalizeShift returns k mod n in [0, n)
+func normalizeShift(k, n int) int {
+	k %= n
+	if k < 0 {
+		k += n
+	}
+
+	return k
+}
+
+// DedupAdjacent returns a new slice with runs of adjacent equal elements collapsed to one
+func DedupAdjacent[T comparable](s Slice[T]) Slice[T] {
+	res := Make[T](0)
+	for i := 0; i < s.Len(); i++ {
+		if v := s.Get(i); i == 0 || v != s.Get(i-1) {
+			res = Append(res, v)
+		}
+	}
+
+	return res
+}
+
+// ShiftRight moves elements right by k in place, dropping the last k and filling the first k with fill
+func (s Slice[T]) ShiftRight(k int, fill T) {
+	if k < 0 {
+		panic("slice.ShiftRight: negative k")
+	}
+	if k > s.Len() {
+		k = s.Len()
+	}
+
+	for i := s.Len() - 1; i >= k; i-- {
+		s.Set(i, s.Get(i-k))
+	}
+	for i := 0; i < k; i++ {
+		s.Set(i, fill)
+	}
+}
+
+// ShiftLeft moves elements left by k in place, dropping the first k and filling the last k with fill
+func (s Slice[T]) ShiftLeft(k int, fill T) {
+	if k < 0 {
+		panic("slice.ShiftLeft: negative k")
+	}
+	if k > s.Len() {
+		k = s.Len()
+	}
+
+	for i := 0; i+k < s.Len(); i++ {
+		s.Set(i, s.Get(i+k))
+	}
+	for i := s.Len() - k; i < s.Len(); i++ {
+		s.Set(i, fill)
+	}
+}
+
+// CloneWithCap returns an independent copy with capacity Len()+extra
+func (s Slice[T]) CloneWithCap(extra int) Slice[T] {
+	if extra < 0 {
+		panic("slice.CloneWithCap: negative extra capacity")
+	}
+
+	res := Make[T](s.Len(), s.Len()+extra)
+	Copy(res, s)
+
+	return res
+}
+
+// FillFrom sets s[i] = src[i % src.Len()] for each i in [0, Len())
+func (s Slice[T]) FillFrom(src Slice[T]) {
+	if src.Len() == 0 {
+		panic("slice.FillFrom: empty source")
+	}
+
+	for i := 0; i < s.Len(); i++ {
+		s.Set(i, src.Get(i%src.Len()))
+	}
+}
+
+// CompactTail zeroes the spare capacity [Len(), Cap()) of the backing array to release references
+// left there by shrinking. It deliberately writes beyond the length, so do not use it while other
+// slices still own that part of the array
+func (s Slice[T]) CompactTail() Slice[T] {
+	if s.IsNil() {
+		return s
+	}
+
+	s.Sliced(0, s.Cap()).clearRange(s.Len(), s.Cap())
+
+	return s
+}
+
+// Transform calls f for each element, every emit(u) call appends u to the result
+func Transform[T, U any](s Slice[T], f func(v T, emit func(U))) Slice[U] {
+	res := Make[U](0)
+	emit := func(u U) {
+		res = Append(res, u)
+	}
+
+	for i := 0; i < s.Len(); i++ {
+		f(s.Get(i), emit)
+	}
+
+	return res
+}
+
+// ReduceWhile folds s with f until f returns false, the accumulator it returned is kept
+func ReduceWhile[T, A any](s Slice[T], init A, f func(acc A, v T) (A, bool)) A {
+	acc := init
+	for i := 0; i < s.Len(); i++ {
+		var next bool
+		acc, next = f(acc, s.Get(i))
+		if !next {
+			break
+		}
+	}
+
+	return acc
+}
+
+// ForEachUntil calls f for each element until f returns false
+func (s Slice[T]) ForEachUntil(f func(T) bool) {
+	for i := 0; i < s.Len(); i++ {
+		if !f(s.Get(i)) {
+			return
+		}
+	}
+}
+
+// DiffIndices returns indices where a and b differ, including all indices past the shorter one
+func DiffIndices[T comparable](a, b Slice[T]) Slice[int] {
+	minLen, maxLen := a.Len(), b.Len()
+	if minLen > maxLen {
+		minLen, maxLen = maxLen, minLen
+	}
+
+	res := Make[int](0)
+	for i := 0; i < minLen; i++ {
+		if a.Get(i) != b.Get(i) {
+			res = Append(res, i)
+		}
+	}
+	for i := minLen; i < maxLen; i++ {
+		res = Append(res, i)
+	}
+
+	return res
+}
+
+// IntersectMultiset keeps each element min(count in a, count in b) times, in order of a
+func IntersectMultiset[T comparable](a, b Slice[T]) Slice[T] {
+	counts := make(map[T]int, b.Len())
+	for i := 0; i < b.Len(); i++ {
+		counts[b.Get(i)]++
+	}
+
+	res := Make[T](0)
+	for i := 0; i < a.Len(); i++ {
+		if v := a.Get(i); counts[v] > 0 {
+			counts[v]--
+			res = Append(res, v)
+		}
+	}
+
+	return res
+}
+
+// RotateBlock rotates s left by k in place (same result as Rotated) with the juggling algorithm:
+// each element is moved exactly once
+func (s Slice[T]) RotateBlock(k int) {
+	n := s.Len()
+	if n == 0 {
+		return
+	}
+
+	k = normalizeShift(k, n)
+	if k == 0 {
+		return
+	}
+
+	cycles := gcd(n, k)
+	for start := 0; start < cycles; start++ {
+		tmp := s.Get(start)
+
+		cur := start
+		for {
+			next := cur + k
+			if next >= n {
+				next -= n
+			}
+			if next == start {
+				break
+			}
+
+			s.Set(cur, s.Get(next))
+			cur = next
+		}
+		s.Set(cur, tmp)
+	}
+}
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+
+	return a
+}
+
+// EqualDetailed returns (true, -1) for equal slices, otherwise false and the first differing index
+// (the shorter length on length mismatch with equal common part)
+func EqualDetailed[T any](a, b Slice[T], eq func(T, T) bool) (bool, int) {
+	minLen := a.Len()
+	if b.Len() < minLen {
+		minLen = b.Len()
+	}
+
+	for i := 0; i < minLen; i++ {
+		if !eq(a.Get(i), b.Get(i)) {
+			return false, i
+		}
+	}
+
+	if a.Len() != b.Len() {
+		return false, minLen
+	}
+
+	return true, -1
+}
+
+// PartitionN distributes elements into n buckets by bucket(v) in [0, n), keeping order within buckets
+func PartitionN[T any](s Slice[T], n int, bucket func(v T) int) Slice[Slice[T]] {
+	if n <= 0 {
+		panic("slice.PartitionN: non-positive buckets count")
+	}
+
+	res := Make[Slice[T]](n)
+	for i := 0; i < n; i++ {
+		res.Set(i, Make[T](0))
+	}
+
+	for i := 0; i < s.Len(); i++ {
+		v := s.Get(i)
+		b := bucket(v)
+		if b < 0 || b >= n {
+			panic("slice.PartitionN: bucket index out of range")
+		}
+		res.Set(b, Append(res.Get(b), v))
+	}
+
+	return res
+}
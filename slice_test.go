@@ -0,0 +1,1123 @@
+package slice
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func toBuiltin[T any](s Slice[T]) []T {
+	res := make([]T, s.Len())
+	for i := 0; i < s.Len(); i++ {
+		res[i] = s.Get(i)
+	}
+
+	return res
+}
+
+func assertElems[T any](t *testing.T, got Slice[T], want ...T) {
+	t.Helper()
+
+	if !reflect.DeepEqual(toBuiltin(got), append([]T{}, want...)) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func assertPanics(t *testing.T, f func()) {
+	t.Helper()
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic")
+		}
+	}()
+	f()
+}
+
+func assertPanicsWith(t *testing.T, msg string, f func()) {
+	t.Helper()
+
+	defer func() {
+		if got := recover(); got != msg {
+			t.Fatalf("panic = %v, want %q", got, msg)
+		}
+	}()
+	f()
+}
+
+func TestSlicedNil(t *testing.T) {
+	var s Slice[int]
+
+	if res := s.Sliced(0, 0); !res.IsNil() {
+		t.Fatalf("Sliced(0, 0) of nil slice is not nil: %v", res)
+	}
+	if res := s.Sliced(0, 0, 0); !res.IsNil() {
+		t.Fatalf("Sliced(0, 0, 0) of nil slice is not nil: %v", res)
+	}
+
+	assertPanics(t, func() { s.Sliced(0, 1) })
+}
+
+func TestAppendNilNoElems(t *testing.T) {
+	var s Slice[int]
+
+	if res := Append(s); !res.IsNil() || res.Len() != 0 {
+		t.Fatalf("Append(nil) = %v, want nil", res)
+	}
+	assertElems(t, Append(s, 1, 2), 1, 2)
+}
+
+func TestRepeat(t *testing.T) {
+	assertElems(t, Repeat("a", 0))
+	assertElems(t, Repeat("a", 1), "a")
+	assertElems(t, Repeat(7, 3), 7, 7, 7)
+
+	p := new(int)
+	res := Repeat(p, 4)
+	for i := 0; i < res.Len(); i++ {
+		if res.Get(i) != p {
+			t.Fatalf("Repeat[%d] holds another pointer", i)
+		}
+	}
+
+	assertPanics(t, func() { Repeat(1, -1) })
+}
+
+func TestGenerate(t *testing.T) {
+	assertElems(t, Generate(5, func(i int) int { return i * i }), 0, 1, 4, 9, 16)
+	assertElems(t, Generate(0, func(i int) int { return i }))
+	assertPanics(t, func() { Generate(-1, func(i int) int { return i }) })
+}
+
+func TestTap(t *testing.T) {
+	s := Make[int](3, 5)
+
+	calls := 0
+	res := s.Tap(func(got Slice[int]) {
+		calls++
+		if got != s {
+			t.Fatal("Tap passed another slice")
+		}
+	})
+
+	if calls != 1 {
+		t.Fatalf("f called %d times, want 1", calls)
+	}
+	if res != s {
+		t.Fatal("Tap returned another slice")
+	}
+}
+
+func assertGroups[T any](t *testing.T, got Slice[Slice[T]], want ...[]T) {
+	t.Helper()
+
+	groups := make([][]T, got.Len())
+	for i := 0; i < got.Len(); i++ {
+		groups[i] = toBuiltin(got.Get(i))
+	}
+
+	if !reflect.DeepEqual(groups, append([][]T{}, want...)) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestSplitFunc(t *testing.T) {
+	changed := func(prev, cur int) bool { return prev != cur }
+	assertGroups(t, SplitFunc(New(1, 1, 2, 3, 3), changed), []int{1, 1}, []int{2}, []int{3, 3})
+
+	notIncreasing := func(prev, cur int) bool { return cur <= prev }
+	assertGroups(t, SplitFunc(New(1, 2, 5, 3, 4, 0), notIncreasing), []int{1, 2, 5}, []int{3, 4}, []int{0})
+
+	assertGroups(t, SplitFunc(New(7), changed), []int{7})
+	assertGroups(t, SplitFunc(New[int](), changed))
+}
+
+func TestSplitFuncGroupsCapacity(t *testing.T) {
+	s := New(1, 1, 2)
+	groups := SplitFunc(s, func(prev, cur int) bool { return prev != cur })
+
+	// Appending to a group must not overwrite the next one
+	Append(groups.Get(0), 9)
+	assertElems(t, s, 1, 1, 2)
+}
+
+func TestGroupConsecutive(t *testing.T) {
+	assertGroups(t, GroupConsecutive(New(1, 2, 1, 2)), []int{1}, []int{2}, []int{1}, []int{2})
+	assertGroups(t, GroupConsecutive(New("a", "a", "b", "b", "b", "a")), []string{"a", "a"}, []string{"b", "b", "b"}, []string{"a"})
+	assertGroups(t, GroupConsecutive(New[int]()))
+}
+
+func TestRunLength(t *testing.T) {
+	s := New("a", "a", "b", "c", "c", "c", "a")
+
+	enc := RunLengthEncode(s)
+	assertElems(t, enc, Pair[string, int]{"a", 2}, Pair[string, int]{"b", 1}, Pair[string, int]{"c", 3}, Pair[string, int]{"a", 1})
+	assertElems(t, RunLengthDecode(enc), toBuiltin(s)...)
+
+	assertElems(t, RunLengthEncode(New[int]()))
+}
+
+func TestRunLengthDecodeSkipsNonPositive(t *testing.T) {
+	res := RunLengthDecode(New(Pair[int, int]{1, 2}, Pair[int, int]{2, 0}, Pair[int, int]{3, -1}, Pair[int, int]{4, 1}))
+	assertElems(t, res, 1, 1, 4)
+}
+
+func TestSplitAt(t *testing.T) {
+	s := New(1, 2, 3, 4)
+
+	for _, tc := range []struct {
+		idx         int
+		left, right []int
+	}{
+		{0, nil, []int{1, 2, 3, 4}},
+		{2, []int{1, 2}, []int{3, 4}},
+		{4, []int{1, 2, 3, 4}, nil},
+		{-1, nil, []int{1, 2, 3, 4}},
+		{9, []int{1, 2, 3, 4}, nil},
+	} {
+		left, right := s.SplitAt(tc.idx)
+		assertElems(t, left, tc.left...)
+		assertElems(t, right, tc.right...)
+	}
+
+	var nilS Slice[int]
+	left, right := nilS.SplitAt(1)
+	if !left.IsNil() || !right.IsNil() {
+		t.Fatal("SplitAt of nil slice must return nil halves")
+	}
+}
+
+func TestSplitAtSharing(t *testing.T) {
+	s := New(1, 2, 3, 4)
+	left, right := s.SplitAt(2)
+
+	right.Set(0, 30)
+	assertElems(t, s, 1, 2, 30, 4)
+
+	// The left half is capacity-limited, appending reallocates
+	Append(left, 9)
+	assertElems(t, right, 30, 4)
+}
+
+func TestSpan(t *testing.T) {
+	less3 := func(v int) bool { return v < 3 }
+
+	prefix, rest := New(1, 2, 3, 1).Span(less3)
+	assertElems(t, prefix, 1, 2)
+	assertElems(t, rest, 3, 1)
+
+	prefix, rest = New(5, 1).Span(less3)
+	assertElems(t, prefix)
+	assertElems(t, rest, 5, 1)
+
+	prefix, rest = New(1, 2).Span(less3)
+	assertElems(t, prefix, 1, 2)
+	assertElems(t, rest)
+}
+
+func TestTranspose(t *testing.T) {
+	assertGroups(t, Transpose(New(New(1, 2, 3), New(4, 5, 6))), []int{1, 4}, []int{2, 5}, []int{3, 6})
+	assertGroups(t, Transpose(New(New(1, 2), New(3, 4), New(5, 6))), []int{1, 3, 5}, []int{2, 4, 6})
+	assertGroups(t, Transpose(New[Slice[int]]()))
+
+	assertPanics(t, func() { Transpose(New(New(1, 2), New(3))) })
+}
+
+func TestStartsEndsWith(t *testing.T) {
+	s := New(1, 2, 3, 4)
+
+	for _, tc := range []struct {
+		affix            Slice[int]
+		starts, endsWith bool
+	}{
+		{New[int](), true, true},
+		{New(1, 2, 3, 4), true, true},
+		{New(1, 2), true, false},
+		{New(3, 4), false, true},
+		{New(1, 3), false, false},
+		{New(1, 2, 3, 4, 5), false, false},
+	} {
+		if got := StartsWith(s, tc.affix); got != tc.starts {
+			t.Fatalf("StartsWith(%v, %v) = %v", s, tc.affix, got)
+		}
+		if got := EndsWith(s, tc.affix); got != tc.endsWith {
+			t.Fatalf("EndsWith(%v, %v) = %v", s, tc.affix, got)
+		}
+	}
+}
+
+var subsliceCases = []struct {
+	s, sub Slice[int]
+	idx    int
+}{
+	{New(1, 2, 3), New(1, 2), 0},
+	{New(1, 2, 3, 4), New(3, 4), 2},
+	{New(1, 2, 1, 2, 3), New(1, 2, 3), 2},
+	{New(1, 2, 2, 3), New(2, 3, 4), -1},
+	{New(1, 2, 3), New(5), -1},
+	{New(1, 2), New(1, 2, 3), -1},
+	{New(1, 2), New[int](), 0},
+	{New[int](), New[int](), 0},
+}
+
+func TestIndexOfSubslice(t *testing.T) {
+	for _, tc := range subsliceCases {
+		if got := IndexOfSubslice(tc.s, tc.sub); got != tc.idx {
+			t.Fatalf("IndexOfSubslice(%v, %v) = %d, want %d", tc.s, tc.sub, got, tc.idx)
+		}
+	}
+}
+
+func TestContainsSubslice(t *testing.T) {
+	for _, tc := range subsliceCases {
+		if got := ContainsSubslice(tc.s, tc.sub); got != (tc.idx >= 0) {
+			t.Fatalf("ContainsSubslice(%v, %v) = %v", tc.s, tc.sub, got)
+		}
+	}
+}
+
+var commonPrefixCases = []struct {
+	a, b   Slice[int]
+	prefix []int
+}{
+	{New(1, 2, 3), New(1, 2, 3), []int{1, 2, 3}},
+	{New(1, 2, 3), New(1, 2, 4, 5), []int{1, 2}},
+	{New(1, 2), New(1, 2, 3), []int{1, 2}},
+	{New(1, 2), New(2, 1), nil},
+	{New[int](), New(1), nil},
+}
+
+func TestCommonPrefix(t *testing.T) {
+	for _, tc := range commonPrefixCases {
+		assertElems(t, CommonPrefix(tc.a, tc.b), tc.prefix...)
+	}
+
+	a := New(1, 2, 3)
+	res := CommonPrefix(a, New(1, 2))
+	res.Set(0, 100)
+	assertElems(t, a, 1, 2, 3)
+}
+
+func TestEqualUnordered(t *testing.T) {
+	for _, tc := range []struct {
+		a, b Slice[int]
+		want bool
+	}{
+		{New(1, 2, 2), New(2, 1, 2), true},
+		{New(1, 2, 2), New(1, 2), false},
+		{New(1, 2, 2), New(1, 1, 2), false},
+		{New[int](), Slice[int]{}, true},
+	} {
+		if got := EqualUnordered(tc.a, tc.b); got != tc.want {
+			t.Fatalf("EqualUnordered(%v, %v) = %v, want %v", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestReject(t *testing.T) {
+	s := New(1, 2, 3, 4, 5)
+	even := func(v int) bool { return v%2 == 0 }
+
+	assertElems(t, Reject(s, even), 1, 3, 5)
+	assertElems(t, Reject(s, func(v int) bool { return !even(v) }), 2, 4)
+	assertElems(t, s, 1, 2, 3, 4, 5)
+	assertElems(t, Reject(New[int](), even))
+}
+
+func TestCountBy(t *testing.T) {
+	counts := CountBy(New("a", "bb", "cc", "d", "eee"), func(s string) int { return len(s) })
+	if want := map[int]int{1: 2, 2: 2, 3: 1}; !reflect.DeepEqual(counts, want) {
+		t.Fatalf("got %v, want %v", counts, want)
+	}
+
+	if counts := CountBy(New[string](), func(s string) int { return len(s) }); len(counts) != 0 {
+		t.Fatalf("got %v for empty input", counts)
+	}
+}
+
+func TestDedupConsecutive(t *testing.T) {
+	eq := func(a, b int) bool { return a == b }
+
+	s := New(1, 1, 2, 2, 2, 3, 1, 1)
+	res := s.DedupConsecutive(eq)
+	assertElems(t, res, 1, 2, 3, 1)
+
+	// Same backing array, freed tail is zeroed
+	assertElems(t, s, 1, 2, 3, 1, 0, 0, 0, 0)
+
+	assertElems(t, New(5, 5, 5).DedupConsecutive(eq), 5)
+	assertElems(t, New[int]().DedupConsecutive(eq))
+}
+
+func TestResize(t *testing.T) {
+	assertElems(t, Resize(New(1, 2), 4, 9), 1, 2, 9, 9)
+
+	s := New(1, 2, 3)
+	res := Resize(s, 1, 9)
+	assertElems(t, res, 1)
+	assertElems(t, s, 1, 0, 0)
+
+	s = New(1, 2)
+	if res := Resize(s, 2, 9); res != s {
+		t.Fatal("Resize to the same length returned another slice")
+	}
+
+	// Growing within capacity reuses the backing array
+	s = Make[int](1, 4)
+	res = Resize(s, 3, 7)
+	assertElems(t, res, 0, 7, 7)
+	if res.Cap() != 4 {
+		t.Fatalf("Cap() = %d, want 4", res.Cap())
+	}
+
+	assertPanics(t, func() { Resize(s, -1, 0) })
+}
+
+func TestPad(t *testing.T) {
+	assertElems(t, PadRight(New(1, 2), 4, 0), 1, 2, 0, 0)
+	assertElems(t, PadLeft(New(1, 2), 4, 0), 0, 0, 1, 2)
+
+	assertElems(t, PadRight(New(1, 2), 2, 0), 1, 2)
+	assertElems(t, PadLeft(New(1, 2), 2, 0), 1, 2)
+
+	assertElems(t, PadRight(New(1, 2, 3), 1, 0), 1, 2, 3)
+	assertElems(t, PadLeft(New(1, 2, 3), 1, 0), 1, 2, 3)
+}
+
+func TestCountDistinct(t *testing.T) {
+	s := Generate(1000, func(i int) int { return i % 7 })
+	if got := CountDistinct(s); got != 7 {
+		t.Fatalf("CountDistinct = %d, want 7", got)
+	}
+
+	if got := CountDistinct(New[int]()); got != 0 {
+		t.Fatalf("CountDistinct of empty = %d, want 0", got)
+	}
+}
+
+type point struct {
+	x, y int
+}
+
+func (p point) String() string {
+	return fmt.Sprintf("(%d;%d)", p.x, p.y)
+}
+
+func TestJoinFunc(t *testing.T) {
+	s := New(point{1, 2}, point{3, 4})
+	if got := JoinFunc(s, ", ", point.String); got != "(1;2), (3;4)" {
+		t.Fatalf("JoinFunc = %q", got)
+	}
+
+	if got := JoinFunc(New(point{1, 2}), ", ", point.String); got != "(1;2)" {
+		t.Fatalf("JoinFunc of one = %q", got)
+	}
+	if got := JoinFunc(New[point](), ", ", point.String); got != "" {
+		t.Fatalf("JoinFunc of empty = %q", got)
+	}
+}
+
+func TestForEachWindow(t *testing.T) {
+	s := New(1, 2, 3, 4, 5)
+
+	var sums []int
+	s.ForEachWindow(3, func(w Slice[int]) {
+		sum := 0
+		for i := 0; i < w.Len(); i++ {
+			sum += w.Get(i)
+		}
+		sums = append(sums, sum)
+	})
+
+	if want := []int{6, 9, 12}; !reflect.DeepEqual(sums, want) {
+		t.Fatalf("moving sums = %v, want %v", sums, want)
+	}
+
+	s.ForEachWindow(6, func(Slice[int]) { t.Fatal("window larger than the slice") })
+	assertPanics(t, func() { s.ForEachWindow(0, func(Slice[int]) {}) })
+}
+
+func TestRemoveFunc(t *testing.T) {
+	even := func(v int) bool { return v%2 == 0 }
+
+	s := New(1, 2, 3, 4, 6)
+	res, n := s.RemoveFunc(even)
+	assertElems(t, res, 1, 3)
+	if n != 3 {
+		t.Fatalf("removed %d, want 3", n)
+	}
+	assertElems(t, s, 1, 3, 0, 0, 0)
+
+	s = New(1, 3)
+	res, n = s.RemoveFunc(even)
+	if n != 0 || res != s {
+		t.Fatalf("no-match RemoveFunc = %v, %d", res, n)
+	}
+}
+
+func TestEqualBy(t *testing.T) {
+	type record struct {
+		id        int
+		updatedAt int64
+	}
+	id := func(r record) int { return r.id }
+
+	a := New(record{1, 100}, record{2, 200})
+	if !EqualBy(a, New(record{1, 111}, record{2, 222}), id) {
+		t.Fatal("records with equal ids are not equal")
+	}
+	if EqualBy(a, New(record{2, 200}, record{1, 100}), id) {
+		t.Fatal("keys must align in order")
+	}
+	if EqualBy(a, New(record{1, 100}), id) {
+		t.Fatal("length mismatch is equal")
+	}
+	if !EqualBy(Slice[record]{}, New[record](), id) {
+		t.Fatal("nil and empty are not equal")
+	}
+}
+
+func TestWithCapacity(t *testing.T) {
+	s := WithCapacity[int](4)
+	if s.Len() != 0 || s.Cap() != 4 {
+		t.Fatalf("Len() = %d, Cap() = %d, want 0, 4", s.Len(), s.Cap())
+	}
+
+	for i := 0; i < 4; i++ {
+		var reused bool
+		s, reused = AppendSafe(s, i)
+		if !reused {
+			t.Fatalf("append %d reallocated", i)
+		}
+	}
+
+	assertPanics(t, func() { WithCapacity[int](-1) })
+}
+
+func TestFillFunc(t *testing.T) {
+	s := New(9, 9, 9)
+	s.FillFunc(func(i int) int { return i * 10 })
+	assertElems(t, s, 0, 10, 20)
+
+	var nilS Slice[int]
+	nilS.FillFunc(func(i int) int { t.Fatal("gen called on nil slice"); return 0 })
+}
+
+func TestMapIndex(t *testing.T) {
+	res := MapIndex(New("a", "b"), func(i int, v string) string { return fmt.Sprintf("%d:%v", i, v) })
+	assertElems(t, res, "0:a", "1:b")
+
+	assertElems(t, MapIndex(New[int](), func(i, v int) int { return v }))
+}
+
+func TestFilterIndex(t *testing.T) {
+	s := New("a", "b", "c", "d", "e")
+	assertElems(t, FilterIndex(s, func(i int, _ string) bool { return i%2 == 0 }), "a", "c", "e")
+	assertElems(t, FilterIndex(s, func(int, string) bool { return false }))
+	assertElems(t, s, "a", "b", "c", "d", "e")
+}
+
+func TestReduceIndex(t *testing.T) {
+	calls := 0
+	dot := ReduceIndex(New(3, 4, 5), 0, func(acc, i, v int) int {
+		calls++
+		return acc + i*v
+	})
+
+	if dot != 0*3+1*4+2*5 || calls != 3 {
+		t.Fatalf("ReduceIndex = %d with %d calls", dot, calls)
+	}
+
+	if got := ReduceIndex(New[int](), 7, func(acc, i, v int) int { return 0 }); got != 7 {
+		t.Fatalf("ReduceIndex of empty = %d, want init", got)
+	}
+}
+
+func TestFindWithIndex(t *testing.T) {
+	s := New("a", "bb", "cc")
+	long := func(v string) bool { return len(v) == 2 }
+
+	if i, v, ok := s.FindWithIndex(long); i != 1 || v != "bb" || !ok {
+		t.Fatalf("FindWithIndex = %d, %q, %v", i, v, ok)
+	}
+
+	if i, v, ok := s.FindWithIndex(func(v string) bool { return v == "z" }); i != -1 || v != "" || ok {
+		t.Fatalf("not found FindWithIndex = %d, %q, %v", i, v, ok)
+	}
+}
+
+func TestIndexOfNth(t *testing.T) {
+	s := New(",", "a", ",", "b", ",")
+
+	for _, tc := range []struct{ n, idx int }{
+		{1, 0},
+		{2, 2},
+		{3, 4},
+		{4, -1},
+		{0, -1},
+		{-1, -1},
+	} {
+		if got := IndexOfNth(s, ",", tc.n); got != tc.idx {
+			t.Fatalf("IndexOfNth(n = %d) = %d, want %d", tc.n, got, tc.idx)
+		}
+	}
+}
+
+func TestMove(t *testing.T) {
+	for _, tc := range []struct {
+		from, to int
+		want     []int
+	}{
+		{1, 3, []int{0, 2, 3, 1, 4}},
+		{3, 1, []int{0, 3, 1, 2, 4}},
+		{0, 4, []int{1, 2, 3, 4, 0}},
+		{4, 0, []int{4, 0, 1, 2, 3}},
+		{2, 2, []int{0, 1, 2, 3, 4}},
+	} {
+		s := Iota(5)
+		s.Move(tc.from, tc.to)
+		assertElems(t, s, tc.want...)
+	}
+
+	assertPanics(t, func() { Iota(5).Move(0, 5) })
+	assertPanics(t, func() { Iota(5).Move(-1, 0) })
+}
+
+func TestFromMap(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+
+	keys := FromMapKeys(m)
+	if keys.Len() != len(m) || !EqualUnordered(keys, New("a", "b", "c")) {
+		t.Fatalf("FromMapKeys = %v", keys)
+	}
+
+	values := FromMapValues(m)
+	if values.Len() != len(m) || !EqualUnordered(values, New(1, 2, 3)) {
+		t.Fatalf("FromMapValues = %v", values)
+	}
+
+	assertElems(t, FromMapKeys(map[int]int{}))
+	assertElems(t, FromMapValues(map[int]int{}))
+}
+
+func TestApply(t *testing.T) {
+	odd := func(s Slice[int]) Slice[int] {
+		return Reject(s, func(v int) bool { return v%2 == 0 })
+	}
+	reverse := func(s Slice[int]) Slice[int] {
+		res := Make[int](s.Len())
+		for i := 0; i < s.Len(); i++ {
+			res.Set(i, s.Get(s.Len()-1-i))
+		}
+		return res
+	}
+
+	assertElems(t, Iota(6).Apply(odd, reverse), 5, 3, 1)
+	assertElems(t, Iota(3).Apply(), 0, 1, 2)
+}
+
+func TestIndicesWhere(t *testing.T) {
+	s := New(5, 2, 8, 1, 9, 4)
+	assertElems(t, s.IndicesWhere(func(v int) bool { return v > 4 }), 0, 2, 4)
+	assertElems(t, s.IndicesWhere(func(v int) bool { return v > 100 }))
+}
+
+func TestAppendClone(t *testing.T) {
+	parent := New(1, 2, 3, 4)
+	first, sibling := parent.Sliced(0, 2), parent.Sliced(2, 4)
+
+	res := AppendClone(first, 9)
+	assertElems(t, res, 1, 2, 9)
+	assertElems(t, sibling, 3, 4)
+
+	Append(first, 9)
+	assertElems(t, sibling, 9, 4)
+}
+
+func TestAppendCloneCapacity(t *testing.T) {
+	s := Make[int](2, 1000)
+
+	res := AppendClone(s, 1)
+	if res.Cap() >= s.Cap() {
+		t.Fatalf("Cap() = %d, sized from the source capacity", res.Cap())
+	}
+	assertElems(t, res, 0, 0, 1)
+
+	var nilS Slice[int]
+	assertElems(t, AppendClone(nilS))
+	assertElems(t, AppendClone(nilS, 1), 1)
+}
+
+func TestAppendSafe(t *testing.T) {
+	s := Make[int](2, 3)
+
+	res, reused := AppendSafe(s, 1)
+	if !reused {
+		t.Fatal("in-capacity append reported reallocation")
+	}
+	assertElems(t, res, 0, 0, 1)
+	assertElems(t, s.Sliced(0, 3), 0, 0, 1)
+
+	grown, reused := AppendSafe(res, 2)
+	if reused {
+		t.Fatal("reallocating append reported reuse")
+	}
+	assertElems(t, grown, 0, 0, 1, 2)
+
+	grown.Set(0, 100)
+	assertElems(t, res, 0, 0, 1)
+}
+
+func TestFirstLastN(t *testing.T) {
+	s := New(1, 2, 3, 4)
+
+	assertElems(t, s.FirstN(2), 1, 2)
+	assertElems(t, s.LastN(2), 3, 4)
+	assertElems(t, s.FirstN(10), 1, 2, 3, 4)
+	assertElems(t, s.LastN(10), 1, 2, 3, 4)
+	assertElems(t, s.FirstN(-1))
+	assertElems(t, s.LastN(-1))
+
+	first, last := s.FirstN(2), s.LastN(2)
+	first.Set(0, 100)
+	last.Set(0, 100)
+	assertElems(t, s, 1, 2, 3, 4)
+
+	if Overlaps(first, s) || Overlaps(last, s) {
+		t.Fatal("FirstN/LastN share memory with the source")
+	}
+}
+
+func TestEveryNth(t *testing.T) {
+	s := Iota(7)
+
+	res := s.EveryNth(1)
+	assertElems(t, res, 0, 1, 2, 3, 4, 5, 6)
+	res.Set(0, 100)
+	assertElems(t, s, 0, 1, 2, 3, 4, 5, 6)
+
+	assertElems(t, s.EveryNth(3), 0, 3, 6)
+	assertElems(t, s.EveryNth(100), 0)
+	assertElems(t, New[int]().EveryNth(2))
+
+	assertPanics(t, func() { s.EveryNth(0) })
+}
+
+// rotateReference rotates left by k the obvious way
+func rotateReference(s Slice[int], k int) []int {
+	n := s.Len()
+	res := make([]int, n)
+	for i := 0; i < n; i++ {
+		res[i] = s.Get(((i+k)%n + n) % n)
+	}
+
+	return res
+}
+
+func TestRotated(t *testing.T) {
+	s := New(1, 2, 3, 4, 5)
+
+	assertElems(t, s.Rotated(2), 3, 4, 5, 1, 2)
+	assertElems(t, s.Rotated(-1), 5, 1, 2, 3, 4)
+	assertElems(t, s.Rotated(7), 3, 4, 5, 1, 2)
+	assertElems(t, s.Rotated(0), 1, 2, 3, 4, 5)
+	assertElems(t, s, 1, 2, 3, 4, 5)
+	assertElems(t, New[int]().Rotated(3))
+
+	for k := -12; k <= 12; k++ {
+		assertElems(t, s.Rotated(k), rotateReference(s, k)...)
+	}
+}
+
+func TestDedupAdjacent(t *testing.T) {
+	s := New(1, 1, 2, 2, 2, 1, 3)
+	assertElems(t, DedupAdjacent(s), 1, 2, 1, 3)
+	assertElems(t, s, 1, 1, 2, 2, 2, 1, 3)
+
+	assertElems(t, DedupAdjacent(New(4, 4, 4, 4)), 4)
+	assertElems(t, DedupAdjacent(New[int]()))
+}
+
+func TestShift(t *testing.T) {
+	for _, tc := range []struct {
+		k           int
+		right, left []int
+	}{
+		{0, []int{1, 2, 3, 4}, []int{1, 2, 3, 4}},
+		{1, []int{0, 1, 2, 3}, []int{2, 3, 4, 0}},
+		{4, []int{0, 0, 0, 0}, []int{0, 0, 0, 0}},
+		{9, []int{0, 0, 0, 0}, []int{0, 0, 0, 0}},
+	} {
+		right, left := New(1, 2, 3, 4), New(1, 2, 3, 4)
+		right.ShiftRight(tc.k, 0)
+		left.ShiftLeft(tc.k, 0)
+
+		assertElems(t, right, tc.right...)
+		assertElems(t, left, tc.left...)
+	}
+
+	assertPanics(t, func() { New(1).ShiftRight(-1, 0) })
+	assertPanics(t, func() { New(1).ShiftLeft(-1, 0) })
+}
+
+func TestCommonPrefixLength(t *testing.T) {
+	for _, tc := range commonPrefixCases {
+		got := CommonPrefixLength(tc.a, tc.b)
+		if got != len(tc.prefix) || got != CommonPrefix(tc.a, tc.b).Len() {
+			t.Fatalf("CommonPrefixLength(%v, %v) = %d, want %d", tc.a, tc.b, got, len(tc.prefix))
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	var nilS Slice[int]
+	for _, s := range []Slice[int]{nilS, New(1, 2), Make[int](1, 5).Sliced(1, 3, 4)} {
+		if err := s.Validate(); err != nil {
+			t.Fatalf("Validate(%v) = %v", s, err)
+		}
+	}
+
+	array := make([]int, 2, 4)
+	for _, s := range []Slice[int]{
+		{&array, -1, 4},
+		{&array, 3, 2},
+		{nil, 0, 3},
+		{&array, 2, 5},
+		{&array, 3, 4},
+	} {
+		if err := s.Validate(); err == nil {
+			t.Fatalf("Validate(%+v) accepted an inconsistent slice", s)
+		}
+	}
+}
+
+// builtinSliced applies idx to ref with the builtin slice expression
+func builtinSliced(ref []int, idx []int) []int {
+	if len(idx) == 3 {
+		return ref[idx[0]:idx[1]:idx[2]]
+	}
+
+	return ref[idx[0]:idx[1]]
+}
+
+// assertMatchesBuiltin compares s against an independently built builtin slice
+func assertMatchesBuiltin(t *testing.T, s Slice[int], ref []int) {
+	t.Helper()
+
+	if s.Len() != len(ref) || s.Cap() != cap(ref) {
+		t.Fatalf("Len() = %d, Cap() = %d, want %d, %d", s.Len(), s.Cap(), len(ref), cap(ref))
+	}
+	assertElems(t, s, ref...)
+}
+
+func TestSlicedCapacity(t *testing.T) {
+	parent := Make[int](6, 10)
+	ref := make([]int, 6, 10)
+
+	full := parent.Sliced(0, 10)
+	for i := 0; i < 10; i++ {
+		full.Set(i, i)
+		ref[:10][i] = i
+	}
+
+	for _, idx := range [][]int{
+		{0, 0},
+		{0, 4},
+		{0, 10},
+		{2, 4},
+		{6, 10},
+		{10, 10},
+		{0, 4, 6},
+		{2, 4, 6},
+		{3, 3, 3},
+		{4, 8, 10},
+	} {
+		s, want := parent.Sliced(idx...), builtinSliced(ref, idx)
+		assertMatchesBuiltin(t, s, want)
+
+		// And after reslicing the view once more
+		assertMatchesBuiltin(t, s.Sliced(0, s.Len()), want[0:len(want)])
+		if c := cap(want); c > 0 {
+			assertMatchesBuiltin(t, s.Sliced(c/2, c), want[c/2:c])
+		}
+	}
+}
+
+func TestCloneWithCap(t *testing.T) {
+	s := New(1, 2, 3)
+
+	res := s.CloneWithCap(2)
+	assertElems(t, res, 1, 2, 3)
+	if res.Cap() != 5 {
+		t.Fatalf("Cap() = %d, want 5", res.Cap())
+	}
+
+	res.Set(0, 100)
+	assertElems(t, s, 1, 2, 3)
+
+	res, reused := AppendSafe(res, 4, 5)
+	if !reused {
+		t.Fatal("appending the extra elements reallocated")
+	}
+
+	assertPanics(t, func() { s.CloneWithCap(-1) })
+}
+
+func TestFillFrom(t *testing.T) {
+	s := Make[int](5)
+	s.FillFrom(New(1, 2))
+	assertElems(t, s, 1, 2, 1, 2, 1)
+
+	s = Make[int](3)
+	s.FillFrom(New(1, 2, 3))
+	assertElems(t, s, 1, 2, 3)
+
+	s = Make[int](2)
+	s.FillFrom(New(1, 2, 3))
+	assertElems(t, s, 1, 2)
+
+	assertPanics(t, func() { s.FillFrom(New[int]()) })
+}
+
+func TestCompactTail(t *testing.T) {
+	a, b := 1, 2
+	s := New(&a, &b, &a).Sliced(0, 1)
+
+	res := s.CompactTail()
+	if res != s || res.Get(0) != &a {
+		t.Fatal("CompactTail changed the visible elements")
+	}
+
+	full := s.Sliced(0, s.Cap())
+	for i := s.Len(); i < full.Len(); i++ {
+		if full.Get(i) != nil {
+			t.Fatalf("spare slot %d still holds a pointer", i)
+		}
+	}
+
+	var nilS Slice[*int]
+	nilS.CompactTail()
+}
+
+func TestTransform(t *testing.T) {
+	s := New(1, 2, 3)
+
+	double := Transform(s, func(v int, emit func(int)) { emit(v * 2) })
+	assertElems(t, double, 2, 4, 6)
+
+	odd := Transform(s, func(v int, emit func(int)) {
+		if v%2 == 1 {
+			emit(v)
+		}
+	})
+	assertElems(t, odd, 1, 3)
+
+	expanded := Transform(s, func(v int, emit func(string)) {
+		for i := 0; i < v; i++ {
+			emit(fmt.Sprint(v))
+		}
+	})
+	assertElems(t, expanded, "1", "2", "2", "3", "3", "3")
+}
+
+func TestReduceWhile(t *testing.T) {
+	consumed := 0
+	sum := ReduceWhile(New(5, 5, 5, 5, 5), 0, func(acc, v int) (int, bool) {
+		consumed++
+		acc += v
+		return acc, acc < 12
+	})
+
+	if sum != 15 || consumed != 3 {
+		t.Fatalf("ReduceWhile = %d after %d elements, want 15 after 3", sum, consumed)
+	}
+
+	if got := ReduceWhile(New[int](), 7, func(acc, v int) (int, bool) { return 0, true }); got != 7 {
+		t.Fatalf("ReduceWhile of empty = %d, want init", got)
+	}
+}
+
+func TestForEachUntil(t *testing.T) {
+	var seen []int
+	New(1, 2, 3, 4).ForEachUntil(func(v int) bool {
+		seen = append(seen, v)
+		return v < 2
+	})
+	assertElems(t, New(seen...), 1, 2)
+
+	var nilS Slice[int]
+	nilS.ForEachUntil(func(int) bool { t.Fatal("f called on nil slice"); return true })
+}
+
+func TestDiffIndices(t *testing.T) {
+	assertElems(t, DiffIndices(New(1, 2, 3), New(1, 2, 3)))
+	assertElems(t, DiffIndices(New(1, 2, 3, 4), New(1, 0, 3, 0)), 1, 3)
+	assertElems(t, DiffIndices(New(1, 2), New(1, 5, 6, 7)), 1, 2, 3)
+	assertElems(t, DiffIndices(New(1, 2, 3), New(1)), 1, 2)
+}
+
+func TestIntersectMultiset(t *testing.T) {
+	a, b := New(1, 2, 2, 3, 2), New(2, 2, 4, 1)
+
+	// A set intersection would give [1 2]
+	assertElems(t, IntersectMultiset(a, b), 1, 2, 2)
+	assertElems(t, IntersectMultiset(b, a), 2, 2, 1)
+	assertElems(t, IntersectMultiset(a, New[int]()))
+}
+
+func TestRotateBlock(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for iter := 0; iter < 20000; iter++ {
+		s := Iota(r.Intn(20))
+		k := r.Intn(50) - 25
+
+		want := s.Rotated(k)
+		viaReverse := s.Rotated(0)
+
+		s.RotateBlock(k)
+		assertElems(t, s, toBuiltin(want)...)
+
+		if viaReverse.Len() > 0 {
+			rotateByReverse(viaReverse, k)
+			assertElems(t, viaReverse, toBuiltin(want)...)
+		}
+	}
+}
+
+// rotateByReverse is the classic three-reversal rotation, returns the number of Set calls
+func rotateByReverse(s Slice[int], k int) int {
+	writes := 0
+	reverse := func(low, high int) {
+		for ; low < high; low, high = low+1, high-1 {
+			lv, hv := s.Get(low), s.Get(high)
+			s.Set(low, hv)
+			s.Set(high, lv)
+			writes += 2
+		}
+	}
+
+	k = normalizeShift(k, s.Len())
+	reverse(0, k-1)
+	reverse(k, s.Len()-1)
+	reverse(0, s.Len()-1)
+
+	return writes
+}
+
+// rotateBlockCounted is RotateBlock with every Set counted, checked against it in TestRotateBlockWrites
+func rotateBlockCounted(s Slice[int], k int) int {
+	n := s.Len()
+	if n == 0 {
+		return 0
+	}
+
+	k = normalizeShift(k, n)
+	if k == 0 {
+		return 0
+	}
+
+	writes := 0
+	cycles := gcd(n, k)
+	for start := 0; start < cycles; start++ {
+		tmp := s.Get(start)
+
+		cur := start
+		for {
+			next := cur + k
+			if next >= n {
+				next -= n
+			}
+			if next == start {
+				break
+			}
+
+			s.Set(cur, s.Get(next))
+			writes++
+			cur = next
+		}
+		s.Set(cur, tmp)
+		writes++
+	}
+
+	return writes
+}
+
+func TestRotateBlockWrites(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+
+	for iter := 0; iter < 2000; iter++ {
+		s := Iota(1 + r.Intn(50))
+		k := r.Intn(100) - 50
+
+		counted := s.Rotated(0)
+		viaReverse := s.Rotated(0)
+
+		s.RotateBlock(k)
+		blockWrites := rotateBlockCounted(counted, k)
+		reverseWrites := rotateByReverse(viaReverse, k)
+		assertElems(t, counted, toBuiltin(s)...)
+
+		// Juggling writes each element at most once
+		if blockWrites > s.Len() {
+			t.Fatalf("RotateBlock(%d) on %d elements did %d writes", k, s.Len(), blockWrites)
+		}
+		if blockWrites > reverseWrites {
+			t.Fatalf("RotateBlock(%d) did %d writes, reversal did %d", k, blockWrites, reverseWrites)
+		}
+	}
+}
+
+const rotateBenchLen, rotateBenchShift = 1 << 16, 12345
+
+func BenchmarkRotateBlock(b *testing.B) {
+	s := Iota(rotateBenchLen)
+	for i := 0; i < b.N; i++ {
+		s.RotateBlock(rotateBenchShift)
+	}
+
+	b.StopTimer()
+	b.ReportMetric(float64(rotateBlockCounted(s, rotateBenchShift)), "writes/op")
+}
+
+func BenchmarkRotateByReverse(b *testing.B) {
+	s := Iota(rotateBenchLen)
+	writes := 0
+	for i := 0; i < b.N; i++ {
+		writes += rotateByReverse(s, rotateBenchShift)
+	}
+
+	b.ReportMetric(float64(writes)/float64(b.N), "writes/op")
+}
+
+func TestEqualDetailed(t *testing.T) {
+	eq := func(a, b int) bool { return a == b }
+
+	for _, tc := range []struct {
+		a, b  Slice[int]
+		equal bool
+		idx   int
+	}{
+		{New(1, 2, 3), New(1, 2, 3), true, -1},
+		{New[int](), Slice[int]{}, true, -1},
+		{New(1, 2, 3), New(1, 5, 3), false, 1},
+		{New(1, 2), New(1, 2, 3), false, 2},
+		{New(1, 2, 3), New(9), false, 0},
+	} {
+		equal, idx := EqualDetailed(tc.a, tc.b, eq)
+		if equal != tc.equal || idx != tc.idx {
+			t.Fatalf("EqualDetailed(%v, %v) = %v, %d, want %v, %d", tc.a, tc.b, equal, idx, tc.equal, tc.idx)
+		}
+	}
+}
+
+func TestPartitionN(t *testing.T) {
+	mod3 := func(v int) int { return v % 3 }
+	assertGroups(t, PartitionN(Iota(8), 3, mod3), []int{0, 3, 6}, []int{1, 4, 7}, []int{2, 5})
+
+	assertGroups(t, PartitionN(New(3, 6), 3, mod3), []int{3, 6}, []int{}, []int{})
+
+	assertPanics(t, func() { PartitionN(Iota(3), 0, mod3) })
+	assertPanics(t, func() { PartitionN(Iota(3), 2, mod3) })
+}
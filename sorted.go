@@ -0,0 +1,144 @@
+package slice
+
+import "cmp"
+
+// MergeSorted merges a and b, both must be sorted by less. Stable: on ties elements of a go first
+func MergeSorted[T any](a, b Slice[T], less func(x, y T) bool) Slice[T] {
+	res := Make[T](a.Len() + b.Len())
+
+	i, j, k := 0, 0, 0
+	for i < a.Len() && j < b.Len() {
+		if less(b.Get(j), a.Get(i)) {
+			res.Set(k, b.Get(j))
+			j++
+		} else {
+			res.Set(k, a.Get(i))
+			i++
+		}
+		k++
+	}
+
+	for ; i < a.Len(); i++ {
+		res.Set(k, a.Get(i))
+		k++
+	}
+	for ; j < b.Len(); j++ {
+		res.Set(k, b.Get(j))
+		k++
+	}
+
+	return res
+}
+
+// KMerge merges sorted slices in O(n log k), on ties earlier slices go first
+func KMerge[T any](slices Slice[Slice[T]], less func(x, y T) bool) Slice[T] {
+	value := func(c kMergeCursor) T {
+		return slices.Get(c.slice).Get(c.pos)
+	}
+
+	pq := NewPriorityQueue(func(a, b kMergeCursor) bool {
+		va, vb := value(a), value(b)
+		if less(va, vb) {
+			return true
+		}
+		if less(vb, va) {
+			return false
+		}
+
+		return a.slice < b.slice
+	})
+
+	total := 0
+	for i := 0; i < slices.Len(); i++ {
+		if n := slices.Get(i).Len(); n > 0 {
+			total += n
+			pq.Push(kMergeCursor{slice: i})
+		}
+	}
+
+	res := Make[T](total)
+	for k := 0; k < total; k++ {
+		c, _ := pq.Pop()
+		res.Set(k, value(c))
+
+		c.pos++
+		if c.pos < slices.Get(c.slice).Len() {
+			pq.Push(c)
+		}
+	}
+
+	return res
+}
+
+type kMergeCursor struct {
+	slice, pos int
+}
+
+// SortedInsertUnique inserts val keeping s sorted by less, unless an equal element is already there.
+// Like Append, it may reuse the backing array of s
+func SortedInsertUnique[T any](s Slice[T], val T, less func(a, b T) bool) (Slice[T], bool) {
+	idx := lowerBoundFunc(s, val, less)
+	if idx < s.Len() && !less(val, s.Get(idx)) {
+		return s, false
+	}
+
+	var zero T
+	res := Append(s, zero)
+	for i := res.Len() - 1; i > idx; i-- {
+		res.Set(i, res.Get(i-1))
+	}
+	res.Set(idx, val)
+
+	return res, true
+}
+
+// ContainsSorted reports whether target is in s using binary search, s must be sorted ascending
+func ContainsSorted[T cmp.Ordered](s Slice[T], target T) bool {
+	idx := LowerBound(s, target)
+	return idx < s.Len() && s.Get(idx) == target
+}
+
+// CountSorted counts occurrences of target using binary search, s must be sorted ascending
+func CountSorted[T cmp.Ordered](s Slice[T], target T) int {
+	return UpperBound(s, target) - LowerBound(s, target)
+}
+
+// LowerBound returns the first index with s[i] >= target, s must be sorted ascending
+func LowerBound[T cmp.Ordered](s Slice[T], target T) int {
+	return lowerBoundFunc(s, target, cmp.Less[T])
+}
+
+// UpperBound returns the first index with s[i] > target, s must be sorted ascending
+func UpperBound[T cmp.Ordered](s Slice[T], target T) int {
+	return upperBoundFunc(s, target, cmp.Less[T])
+}
+
+// lowerBoundFunc returns the first index i with !less(s[i], target)
+func lowerBoundFunc[T any](s Slice[T], target T, less func(a, b T) bool) int {
+	low, high := 0, s.Len()
+	for low < high {
+		mid := int(uint(low+high) >> 1)
+		if less(s.Get(mid), target) {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+
+	return low
+}
+
+// upperBoundFunc returns the first index i with less(target, s[i])
+func upperBoundFunc[T any](s Slice[T], target T, less func(a, b T) bool) int {
+	low, high := 0, s.Len()
+	for low < high {
+		mid := int(uint(low+high) >> 1)
+		if !less(target, s.Get(mid)) {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+
+	return low
+}
@@ -0,0 +1,206 @@
+package slice
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func intLess(a, b int) bool {
+	return a < b
+}
+
+func TestMergeSorted(t *testing.T) {
+	assertElems(t, MergeSorted(New(1, 3, 5), New(2, 4, 6), intLess), 1, 2, 3, 4, 5, 6)
+	assertElems(t, MergeSorted(New(1, 2), New(7, 8, 9), intLess), 1, 2, 7, 8, 9)
+	assertElems(t, MergeSorted(New(7, 8), New(1, 2), intLess), 1, 2, 7, 8)
+	assertElems(t, MergeSorted(New[int](), New(1, 2), intLess), 1, 2)
+	assertElems(t, MergeSorted(New(1, 2), New[int](), intLess), 1, 2)
+	assertElems(t, MergeSorted(New[int](), New[int](), intLess))
+}
+
+func TestMergeSortedStable(t *testing.T) {
+	type item struct {
+		key  int
+		from string
+	}
+	less := func(x, y item) bool { return x.key < y.key }
+
+	res := MergeSorted(New(item{1, "a"}, item{2, "a"}), New(item{1, "b"}, item{2, "b"}), less)
+	assertElems(t, res, item{1, "a"}, item{1, "b"}, item{2, "a"}, item{2, "b"})
+}
+
+func TestKMerge(t *testing.T) {
+	slices := New(New(1, 4, 7), New[int](), New(2, 5, 8), New(0, 3, 6, 9))
+	assertElems(t, KMerge(slices, intLess), 0, 1, 2, 3, 4, 5, 6, 7, 8, 9)
+
+	assertElems(t, KMerge(New[Slice[int]](), intLess))
+	assertElems(t, KMerge(New(New[int](), New[int]()), intLess))
+}
+
+func TestKMergeStable(t *testing.T) {
+	type item struct {
+		key, from int
+	}
+	less := func(x, y item) bool { return x.key < y.key }
+
+	res := KMerge(New(New(item{1, 0}), New(item{1, 1}), New(item{0, 2}, item{1, 2})), less)
+	assertElems(t, res, item{0, 2}, item{1, 0}, item{1, 1}, item{1, 2})
+}
+
+func TestKMergeRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for iter := 0; iter < 500; iter++ {
+		k := r.Intn(6)
+		slices := Make[Slice[int]](k)
+		var all []int
+		for i := 0; i < k; i++ {
+			elems := make([]int, r.Intn(20))
+			for j := range elems {
+				elems[j] = r.Intn(50)
+			}
+			sort.Ints(elems)
+
+			all = append(all, elems...)
+			slices.Set(i, New(elems...))
+		}
+		sort.Ints(all)
+
+		assertElems(t, KMerge(slices, intLess), all...)
+	}
+}
+
+func TestSortedInsertUnique(t *testing.T) {
+	s, ok := SortedInsertUnique(New[int](), 5, intLess)
+	if !ok {
+		t.Fatal("insert into empty slice failed")
+	}
+	assertElems(t, s, 5)
+
+	s = New(1, 3, 5)
+	s, ok = SortedInsertUnique(s, 4, intLess)
+	if !ok {
+		t.Fatal("insert of a new value failed")
+	}
+	assertElems(t, s, 1, 3, 4, 5)
+
+	s, ok = SortedInsertUnique(s, 3, intLess)
+	if ok {
+		t.Fatal("duplicate inserted")
+	}
+	assertElems(t, s, 1, 3, 4, 5)
+
+	s, _ = SortedInsertUnique(s, 0, intLess)
+	s, _ = SortedInsertUnique(s, 9, intLess)
+	assertElems(t, s, 0, 1, 3, 4, 5, 9)
+}
+
+func containsLinear[T comparable](s Slice[T], target T) bool {
+	for i := 0; i < s.Len(); i++ {
+		if s.Get(i) == target {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestContainsSorted(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for iter := 0; iter < 500; iter++ {
+		elems := make([]int, r.Intn(30))
+		for i := range elems {
+			elems[i] = r.Intn(40)
+		}
+		sort.Ints(elems)
+		s := New(elems...)
+
+		for target := -1; target <= 40; target++ {
+			if got, want := ContainsSorted(s, target), containsLinear(s, target); got != want {
+				t.Fatalf("ContainsSorted(%v, %d) = %v, want %v", s, target, got, want)
+			}
+		}
+	}
+}
+
+var sortedBenchSlice = Iota(1 << 20)
+
+func BenchmarkContainsSorted(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		ContainsSorted(sortedBenchSlice, sortedBenchSlice.Len()-1)
+	}
+}
+
+func BenchmarkContainsLinear(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		containsLinear(sortedBenchSlice, sortedBenchSlice.Len()-1)
+	}
+}
+
+func countLinear[T comparable](s Slice[T], target T) int {
+	n := 0
+	for i := 0; i < s.Len(); i++ {
+		if s.Get(i) == target {
+			n++
+		}
+	}
+
+	return n
+}
+
+func TestCountSorted(t *testing.T) {
+	s := New(1, 2, 2, 2, 3, 5)
+
+	for _, tc := range []struct{ target, count int }{
+		{0, 0},
+		{4, 0},
+		{1, 1},
+		{5, 1},
+		{2, 3},
+	} {
+		if got := CountSorted(s, tc.target); got != tc.count {
+			t.Fatalf("CountSorted(%d) = %d, want %d", tc.target, got, tc.count)
+		}
+	}
+}
+
+var countBenchSlice = Generate(1<<20, func(i int) int { return i / 16 })
+
+func BenchmarkCountSorted(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		CountSorted(countBenchSlice, 1000)
+	}
+}
+
+func BenchmarkCountLinear(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		countLinear(countBenchSlice, 1000)
+	}
+}
+
+func TestLowerUpperBound(t *testing.T) {
+	s := New(1, 2, 2, 2, 3, 5)
+
+	for _, tc := range []struct{ target, lower, upper int }{
+		{0, 0, 0},
+		{1, 0, 1},
+		{2, 1, 4},
+		{3, 4, 5},
+		{4, 5, 5},
+		{5, 5, 6},
+		{6, 6, 6},
+	} {
+		if got := LowerBound(s, tc.target); got != tc.lower {
+			t.Fatalf("LowerBound(%d) = %d, want %d", tc.target, got, tc.lower)
+		}
+		if got := UpperBound(s, tc.target); got != tc.upper {
+			t.Fatalf("UpperBound(%d) = %d, want %d", tc.target, got, tc.upper)
+		}
+	}
+
+	if LowerBound(New[int](), 1) != 0 || UpperBound(New[int](), 1) != 0 {
+		t.Fatal("bounds of empty slice must be 0")
+	}
+}
@@ -0,0 +1,41 @@
+package slice
+
+// Stack LIFO, zero value is ready to use
+type Stack[T any] struct {
+	elems Slice[T]
+}
+
+func (st *Stack[T]) Push(val T) {
+	st.elems = Append(st.elems, val)
+}
+
+func (st *Stack[T]) Pop() (T, bool) {
+	var zero T
+	if st.IsEmpty() {
+		return zero, false
+	}
+
+	last := st.elems.Len() - 1
+	val := st.elems.Get(last)
+	st.elems.Set(last, zero) // Release reference
+	st.elems = st.elems.Sliced(0, last)
+
+	return val, true
+}
+
+func (st *Stack[T]) Peek() (T, bool) {
+	if st.IsEmpty() {
+		var zero T
+		return zero, false
+	}
+
+	return st.elems.Get(st.elems.Len() - 1), true
+}
+
+func (st *Stack[T]) Len() int {
+	return st.elems.Len()
+}
+
+func (st *Stack[T]) IsEmpty() bool {
+	return st.elems.Len() == 0
+}
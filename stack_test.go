@@ -0,0 +1,44 @@
+package slice
+
+import "testing"
+
+func TestStack(t *testing.T) {
+	var st Stack[int]
+	if !st.IsEmpty() {
+		t.Fatal("zero Stack is not empty")
+	}
+
+	st.Push(1)
+	st.Push(2)
+	st.Push(3)
+
+	if v, ok := st.Peek(); !ok || v != 3 {
+		t.Fatalf("Peek() = %v, %v, want 3, true", v, ok)
+	}
+	if st.Len() != 3 {
+		t.Fatalf("Len() = %d, want 3", st.Len())
+	}
+
+	for _, want := range []int{3, 2, 1} {
+		if v, ok := st.Pop(); !ok || v != want {
+			t.Fatalf("Pop() = %v, %v, want %v, true", v, ok, want)
+		}
+	}
+
+	if _, ok := st.Pop(); ok {
+		t.Fatal("Pop() on empty stack succeeded")
+	}
+	if _, ok := st.Peek(); ok {
+		t.Fatal("Peek() on empty stack succeeded")
+	}
+}
+
+func TestStackPopReleasesSlot(t *testing.T) {
+	var st Stack[*int]
+	st.Push(new(int))
+	st.Pop()
+
+	if v := st.elems.Sliced(0, 1).Get(0); v != nil {
+		t.Fatal("popped slot still holds the pointer")
+	}
+}